@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	nc "nexus/pkg/client"
+)
+
+// runExport writes a snapshot of a dataset to a local file
+func runExport(client *nc.NexusClient) {
+	args := positionalArgs()
+	out := flagValue("--out")
+	if len(args) < 3 || out == "" {
+		fmt.Println(`Usage: nexus-client export <path> --out=<file> [--format=csv|json|parquet] [--register=<path>] [--infer]
+
+			Writes the rows of the file, directory, database table, query or event stream
+			registered at <path> to <file>.
+
+			Options:
+			--format=<type>    - File type to write, from the extension of <file> if not given
+			--register=<path>  - Register the exported file at <path>, derived from <path>
+			--infer            - Infer the schema of the exported file before registering it
+			--max-messages=<n> - Stop reading an event stream after n messages, default 1000
+			--window=<time>    - Stop reading an event stream after this long, such as 30s, default 10s
+			--partitions=<ids> - Only read these comma separated partitions of an event stream
+			--from=oldest      - Read an event stream from its oldest retained message
+			--since=<time>     - Read an event stream from a timestamp, or a duration ago such as 1h
+			--last=<n>         - Read the last n messages of each partition of an event stream
+			--offsets=<p:o,..> - Read partitions of an event stream from the given offsets
+			--columns, --where, --limit, --offset, --param, --glob, --source-column and
+			--partitioned select the rows exported as they do for consume`)
+		os.Exit(1)
+	}
+
+	options := nc.ExportOptions{
+		Format: flagValue("--format"),
+		Read:   parseReadOptions(),
+		Directory: nc.DirectoryReadOptions{
+			Pattern:      flagValue("--glob"),
+			SourceColumn: flagValue("--source-column"),
+			Partitioned:  hasFlag("--partitioned"),
+		},
+		Stream:     parseStreamOptions(),
+		RegisterAs: flagValue("--register"),
+		Infer:      hasFlag("--infer"),
+	}
+	if value := flagValue("--max-messages"); value != "" {
+		maxMessages, err := strconv.Atoi(value)
+		if err != nil {
+			fmt.Println("Failed to parse --max-messages:", err)
+			os.Exit(1)
+		}
+		options.MaxMessages = maxMessages
+	}
+	if value := flagValue("--window"); value != "" {
+		window, err := time.ParseDuration(value)
+		if err != nil {
+			fmt.Println("Failed to parse --window:", err)
+			os.Exit(1)
+		}
+		options.Window = window
+	}
+
+	count, err := client.Export(args[2], out, options)
+	if err != nil {
+		fmt.Println("Failed to export dataset:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Exported %d rows to %s\n", count, out)
+	if options.RegisterAs != "" {
+		fmt.Println("Registered at", options.RegisterAs)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	nc "nexus/pkg/client"
+)
+
+// runLineage declares the upstream dependencies of a node or prints the lineage graph around it
+func runLineage(client *nc.NexusClient) {
+	args := positionalArgs()
+	if len(args) < 4 || (args[2] == "declare" && len(args) < 5) || (args[2] != "declare" && args[2] != "show") {
+		fmt.Println(`Usage: nexus-client lineage declare <path> <upstream_path>...
+			       nexus-client lineage show <path> [--upstream|--downstream] [--depth=<n>]
+
+			declare - Record that <path> is derived from the given paths, replacing any declared before
+			show    - Print the paths <path> depends on and the paths depending on it
+
+			Options:
+			--upstream   - Only follow the paths <path> is derived from
+			--downstream - Only follow the paths derived from <path>
+			--depth=<n>  - Stop n edges away from <path>, default unlimited`)
+		os.Exit(1)
+	}
+	path := args[3]
+
+	if args[2] == "declare" {
+		if err := client.DeclareLineage(path, args[4:]...); err != nil {
+			fmt.Println("Failed to declare lineage:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	direction := nc.LineageBoth
+	if hasFlag("--upstream") && !hasFlag("--downstream") {
+		direction = nc.LineageUpstream
+	} else if hasFlag("--downstream") && !hasFlag("--upstream") {
+		direction = nc.LineageDownstream
+	}
+	depth := 0
+	if value := flagValue("--depth"); value != "" {
+		var err error
+		if depth, err = strconv.Atoi(value); err != nil {
+			fmt.Println("Invalid depth:", value)
+			os.Exit(1)
+		}
+	}
+
+	lineage, err := client.GetLineage(path, direction, depth)
+	if err != nil {
+		fmt.Println("Failed to get lineage:", err)
+		os.Exit(1)
+	}
+	for _, node := range lineage.Nodes {
+		line := fmt.Sprintf("%+d %s", node.Depth, node.Path)
+		if node.Missing {
+			line += " <missing>"
+		} else {
+			line += " <" + node.Type + ">"
+		}
+		if node.Health != nil && node.Health.Status != nc.HealthHealthy && node.Health.Status != nc.HealthUnknown {
+			line += " [" + node.Health.Status + "]"
+		}
+		fmt.Println(strings.Repeat("  ", abs(int(node.Depth))) + line)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -1,21 +1,345 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
 	nc "nexus/pkg/client"
 	pb "nexus/pkg/proto"
 	"strconv"
 )
 
+// hasFlag reports whether the given flag was passed on the command line
+func hasFlag(flag string) bool {
+	for _, arg := range os.Args[2:] {
+		if arg == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// positionalArgs returns the command line arguments that are not --flags
+func positionalArgs() []string {
+	var args []string
+	for _, arg := range os.Args {
+		if !strings.HasPrefix(arg, "--") {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// printCursor prints the rows of a cursor batch by batch, headed by each column's name and type
+func printCursor(cursor *nc.Cursor) {
+	defer cursor.Close()
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	header := make([]string, len(cursor.Columns()))
+	for i, column := range cursor.Columns() {
+		header[i] = fmt.Sprintf("%s (%s)", column.Name, column.Type)
+	}
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+	for {
+		batch, err := cursor.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writer.Flush()
+			fmt.Println("Failed to read rows:", err)
+			os.Exit(1)
+		}
+		for _, row := range batch {
+			record := make([]string, len(row))
+			for i, value := range row {
+				record[i] = nc.FormatValue(value)
+			}
+			fmt.Fprintln(writer, strings.Join(record, "\t"))
+		}
+		writer.Flush()
+	}
+}
+
+// printPreview prints the rows of a dataset preview followed by its column statistics
+func printPreview(preview *pb.GetPreviewResponse) {
+	cursor, err := nc.NewCursor(nc.NewTableSource(nc.PreviewTable(preview)), nc.ReadOptions{})
+	if err != nil {
+		fmt.Println("Failed to read preview:", err)
+		os.Exit(1)
+	}
+	printCursor(cursor)
+
+	coverage := "all"
+	if !preview.Complete {
+		coverage = "the first"
+	}
+	fmt.Printf("\nStatistics over %s %d rows, computed %s:\n", coverage, preview.RowsScanned,
+		time.Unix(preview.ComputedAt, 0).Format(time.RFC3339))
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "column\tnulls\tmin\tmax\tdistinct")
+	for _, stats := range preview.Stats {
+		fmt.Fprintf(writer, "%s\t%d\t%s\t%s\t~%d\n", stats.Name, stats.NullCount,
+			nc.FormatValue(nc.CellValue(stats.Min)), nc.FormatValue(nc.CellValue(stats.Max)), stats.DistinctEstimate)
+	}
+	writer.Flush()
+}
+
+// parseReadOptions builds dataset read options from the --columns, --where, --limit,
+// --offset and --param flags
+func parseReadOptions() nc.ReadOptions {
+	options := nc.ReadOptions{}
+	if columns := flagValue("--columns"); columns != "" {
+		options.Columns = strings.Split(columns, ",")
+	}
+	for _, expr := range flagValues("--where") {
+		filter, err := nc.ParseFilter(expr)
+		if err != nil {
+			fmt.Println("Failed to parse filter:", err)
+			os.Exit(1)
+		}
+		options.Filters = append(options.Filters, filter)
+	}
+	for _, param := range flagValues("--param") {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			fmt.Println("Failed to parse query parameter, expected --param=<name>=<value>:", param)
+			os.Exit(1)
+		}
+		if options.Parameters == nil {
+			options.Parameters = map[string]string{}
+		}
+		options.Parameters[name] = value
+	}
+	for flag, target := range map[string]*int64{"--limit": &options.Limit, "--offset": &options.Offset} {
+		if value := flagValue(flag); value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				fmt.Printf("Failed to parse %s: %v\n", flag, err)
+				os.Exit(1)
+			}
+			*target = parsed
+		}
+	}
+	return options
+}
+
+// printMessage prints the value of an event stream message, preceded by its partition, offset,
+// timestamp, key and headers if metadata is set
+func printMessage(message *nc.Message, decoder nc.Decoder, fields []string, metadata bool) {
+	value := formatMessageValue(message, decoder, fields)
+	if !metadata {
+		fmt.Println(value)
+		return
+	}
+	line := fmt.Sprintf("[%d:%d %s]", message.Partition, message.Offset, message.Timestamp.Format(time.RFC3339Nano))
+	if message.Key != nil {
+		line += fmt.Sprintf(" key=%q", message.Key)
+	}
+	for _, header := range message.Headers {
+		line += fmt.Sprintf(" %s=%q", header.Key, header.Value)
+	}
+	fmt.Println(line, value)
+}
+
+// formatMessageValue decodes a message value and pretty-prints it, keeping only the given
+// fields if any. Values are printed raw without a decoder, or if they can't be decoded.
+func formatMessageValue(message *nc.Message, decoder nc.Decoder, fields []string) string {
+	if decoder == nil {
+		return string(message.Value)
+	}
+	record, err := nc.DecodeMessage(decoder, message)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to decode message:", err)
+		return string(message.Value)
+	}
+	data := record.Data
+	if len(fields) > 0 {
+		selected := map[string]interface{}{}
+		for _, field := range fields {
+			if value, ok := record.Field(field); ok {
+				selected[field] = value
+			}
+		}
+		data = selected
+	}
+	if s, ok := data.(string); ok {
+		return s
+	}
+	text, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return nc.FormatData(data)
+	}
+	return string(text)
+}
+
+// parseStreamOptions builds event stream options from the --partitions, --from, --offsets,
+// --since, --last, --group, --no-group and --commit flags
+func parseStreamOptions() nc.StreamOptions {
+	options := nc.StreamOptions{
+		Start:      flagValue("--from"),
+		GroupID:    flagValue("--group"),
+		Standalone: hasFlag("--no-group"),
+		CommitMode: flagValue("--commit"),
+	}
+	var err error
+	if offsets := flagValue("--offsets"); offsets != "" {
+		if options.Offsets, err = nc.ParseOffsets(offsets); err != nil {
+			fmt.Println("Failed to parse --offsets:", err)
+			os.Exit(1)
+		}
+	}
+	if since := flagValue("--since"); since != "" {
+		if options.Since, err = nc.ParseSince(since); err != nil {
+			fmt.Println("Failed to parse --since:", err)
+			os.Exit(1)
+		}
+	}
+	if last := flagValue("--last"); last != "" {
+		if options.LastN, err = strconv.ParseInt(last, 10, 64); err != nil {
+			fmt.Println("Failed to parse --last:", err)
+			os.Exit(1)
+		}
+	}
+	if partitions := flagValue("--partitions"); partitions != "" {
+		for _, value := range strings.Split(partitions, ",") {
+			partition, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+			if err != nil {
+				fmt.Println("Failed to parse --partitions:", err)
+				os.Exit(1)
+			}
+			options.Partitions = append(options.Partitions, int32(partition))
+		}
+	}
+	return options
+}
+
+// parseStreamSettings sets the connection settings and encoding of an event stream from the
+// --transport, --client-id, --kafka-version, --compression, --tls*, --sasl*, --encoding,
+// --schema and --descriptor-set flags, and its last value cache from --latest and --latest-field
+func parseStreamSettings(es *pb.EventStream) {
+	es.Transport = flagValue("--transport")
+	if _, err := nc.GetTransport(es.Transport); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	es.ClientId = flagValue("--client-id")
+	es.KafkaVersion = flagValue("--kafka-version")
+	es.Compression = flagValue("--compression")
+
+	tls := &pb.KafkaTLS{
+		CaFile:             flagValue("--tls-ca"),
+		CertFile:           flagValue("--tls-cert"),
+		KeyFile:            flagValue("--tls-key"),
+		ServerName:         flagValue("--tls-server-name"),
+		InsecureSkipVerify: hasFlag("--tls-insecure"),
+	}
+	if hasFlag("--tls") || tls.CaFile != "" || tls.CertFile != "" || tls.KeyFile != "" || tls.ServerName != "" || tls.InsecureSkipVerify {
+		es.Tls = tls
+	}
+
+	mechanism, credential := flagValue("--sasl"), flagValue("--sasl-credential")
+	if mechanism != "" || credential != "" {
+		if credential == "" {
+			fmt.Println("--sasl needs --sasl-credential=<ref>")
+			os.Exit(1)
+		}
+		es.Sasl = &pb.KafkaSASL{Mechanism: mechanism, CredentialRef: credential}
+	}
+
+	es.Encoding = flagValue("--encoding")
+	es.Schema = flagValue("--schema")
+	if file, ok := strings.CutPrefix(es.Schema, "@"); ok {
+		schema, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Println("Failed to read schema:", err)
+			os.Exit(1)
+		}
+		es.Schema = string(schema)
+	}
+	if file := flagValue("--descriptor-set"); file != "" {
+		descriptorSet, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Println("Failed to read descriptor set:", err)
+			os.Exit(1)
+		}
+		es.DescriptorSet = descriptorSet
+	}
+	if _, err := nc.NewDecoder(es); err != nil {
+		fmt.Println("Invalid encoding:", err)
+		os.Exit(1)
+	}
+
+	field := flagValue("--latest-field")
+	if hasFlag("--latest") || flagValue("--latest") != "" || field != "" {
+		es.LastValue = &pb.LastValueCache{Path: flagValue("--latest"), Field: field}
+	}
+}
+
+// flagValue returns the value of a flag passed on the command line as --name=value
+func flagValue(flag string) string {
+	for _, arg := range os.Args[2:] {
+		if strings.HasPrefix(arg, flag+"=") {
+			return strings.TrimPrefix(arg, flag+"=")
+		}
+	}
+	return ""
+}
+
+// flagValues returns the values of a flag passed on the command line any number of times as --name=value
+func flagValues(flag string) []string {
+	var values []string
+	for _, arg := range os.Args[2:] {
+		if strings.HasPrefix(arg, flag+"=") {
+			values = append(values, strings.TrimPrefix(arg, flag+"="))
+		}
+	}
+	return values
+}
+
+// printUsage prints the commands of the client. Those taking arguments print their own usage
+// when run without them.
+func printUsage() {
+	fmt.Println(`Usage: nexus-client <command> [arguments]
+
+			Commands:
+			publish <type> <path> <data> - Register a file, directory, database table, query, event stream or value
+			consume <path>               - Print the rows of a dataset, the messages of an event stream or a value
+			list [<path>]                - List the children of a path, / by default
+			preview <path>               - Print the cached preview of a dataset
+			subscribe <path>             - Print the registrations, updates and deletions at a path as they happen
+			produce <path>               - Send lines read from stdin as messages to an event stream
+			export <path>                - Write the rows of a dataset or event stream to a file
+			lineage declare|show         - Declare or show the paths a dataset is derived from
+			watch-dir <dir> <prefix>     - Keep the files of a local directory registered
+			secrets set|delete|list      - Manage the credentials in the encrypted secrets file
+			help                         - Print this message
+
+			Common consume options:
+			--remote                     - Read a dataset through the server rather than opening it locally
+			--relay                      - Consume an event stream through the server rather than the brokers
+			`)
+}
+
 func main() {
 
 	if len(os.Args) < 2 {
-		fmt.Println("Usage: nexus-client publish|consume|list")
+		printUsage()
 		os.Exit(1)
 	}
+	switch os.Args[1] {
+	case "help", "-h", "--help":
+		printUsage()
+		return
+	}
 
 	conn, err := nc.CreateGRPCConnection(nc.DefaultConnection)
 	if err != nil {
@@ -38,14 +362,40 @@ func main() {
 	case "publish":
 		if len(os.Args) < 4 {
 			fmt.Println(`
-			Usage: nexus-client publish <type> <path> <data>
+			Usage: nexus-client publish <type> <path> <data> [--infer]
 
 			Types:
 			file - Publish a file
 			directory - Publish a directory
 			DBTable - Publish a database table
+			query - Publish a parameterized query on a published database table
 			event - Publish an event stream
 			value - Publish a value
+
+			Options:
+			--infer      - Infer the schema of a file or directory before publishing
+			--group=<id> - Consumer group joined by consumers of an event stream that don't name one
+
+			Event streams are published as: event <path> <topic> [<server>,...], using the Kafka brokers in
+			$NEXUS_KAFKA_BROKERS if none are given. Their connection is set with:
+			--transport=<name>        - kafka, nats, mqtt, redis or inproc, kafka by default
+			--client-id=<id>          - Client ID reported to the brokers
+			--kafka-version=<version> - Kafka version the brokers run, such as 3.6.0
+			--compression=<codec>     - Codec messages are produced with: none, gzip, snappy, lz4 or zstd
+			--tls                     - Connect over TLS, implied by the other --tls options
+			--tls-ca=<file>           - PEM file of the CAs brokers are verified with
+			--tls-cert=<file>         - PEM client certificate, with --tls-key=<file> for its key
+			--tls-server-name=<name>  - Name verified in broker certificates
+			--tls-insecure            - Don't verify broker certificates
+			--sasl=<mechanism>        - Authenticate with PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512
+			--sasl-credential=<ref>   - Reference to the SASL username and password, such as env:KAFKA
+			Message values are decoded when consumed according to:
+			--encoding=<name>         - utf8, json, protobuf, avro or msgpack
+			--schema=<schema>         - Avro schema, or @<file> to read it, or the protobuf message type name
+			--descriptor-set=<file>   - FileDescriptorSet defining the protobuf message type, from protoc --descriptor_set_out
+			The server keeps the latest message in a value node, updated as messages arrive, with:
+			--latest[=<path>]         - Path of the value node, <path>/latest by default
+			--latest-field=<field>    - Dot separated field of the decoded message to keep, such as price.bid, implies --latest
 			`)
 			os.Exit(1)
 		}
@@ -53,6 +403,12 @@ func main() {
 		switch os.Args[2] {
 		case "file":
 			file := nc.CreateIndividualFile(os.Args[4])
+			if hasFlag("--infer") {
+				if err := nc.InferIndividualFile(file); err != nil {
+					fmt.Println("Failed to infer file schema:", err)
+					os.Exit(1)
+				}
+			}
 			err = client.PublishIndividualFile(path, file)
 			if err != nil {
 				fmt.Println("Failed to publish dataset:", err)
@@ -64,37 +420,126 @@ func main() {
 				fmt.Println("Failed to create directory:", err)
 				os.Exit(1)
 			}
+			if hasFlag("--infer") {
+				if err := nc.InferDirectory(directory); err != nil {
+					fmt.Println("Failed to infer directory schema:", err)
+					os.Exit(1)
+				}
+				for _, name := range directory.MismatchedFiles {
+					fmt.Println("Warning: schema differs from directory:", name)
+				}
+			}
 			err = client.PublishDirectory(path, directory)
 			if err != nil {
 				fmt.Println("Failed to publish directory:", err)
 				os.Exit(1)
 			}
 		case "DBTable":
-			if len(os.Args) < 8 {
-				fmt.Println(`Usage: nexus-client publish DBTable <path> <db_type> <host> <port> <db_name> <table_name>`)
-				os.Exit(1)
+			args := positionalArgs()
+			var database *pb.DatabaseTable
+			if len(args) == 7 && nc.IsFileDatabase(args[4]) {
+				// File based databases only need the database file and table name
+				database = nc.CreateDatabaseTable(args[4], "", 0, args[5], args[6])
+			} else {
+				if len(args) < 9 {
+					fmt.Println(`Usage: nexus-client publish DBTable <path> <db_type> <host> <port> <db_name> <table_name> [--credential=<ref>]
+       nexus-client publish DBTable <path> sqlite <db_file> <table_name>
+
+			Supported database types: postgres, mysql, sqlite
+
+			Options:
+			--credential=<ref> - Credentials to connect with, e.g. env:ANALYTICS or file:prod-db.
+			                     Defaults to credentials stored for the host, then $DB_USER and $DB_PASSWORD
+			                     The server only health checks tables whose reference is named after the host,
+			                     such as env:db.internal:5432 or file:db.internal
+			--schema=<name>    - Schema containing the table
+			--columns=<a,b>    - Columns returned by default
+			--where=<filter>   - Filter every read applies, e.g. --where="age>=30". May be repeated
+			--order-by=<col>   - Column to sort rows by, with --descending to reverse the order
+			--no-verify        - Skip checking that the table exists before publishing`)
+					os.Exit(1)
+				}
+				port, err := strconv.Atoi(args[6])
+				if err != nil {
+					fmt.Println("Failed to convert port to int:", err)
+					os.Exit(1)
+				}
+				database = nc.CreateDatabaseTable(args[4], args[5], int32(port), args[7], args[8])
+				database.CredentialRef = flagValue("--credential")
 			}
-			port, err := strconv.Atoi(os.Args[6])
-			if err != nil {
-				fmt.Println("Failed to convert port to int:", err)
+			database.SchemaName = flagValue("--schema")
+			if columns := flagValue("--columns"); columns != "" {
+				database.Columns = strings.Split(columns, ",")
+			}
+			for _, expr := range flagValues("--where") {
+				filter, err := nc.ParseFilter(expr)
+				if err != nil {
+					fmt.Println("Failed to parse filter:", err)
+					os.Exit(1)
+				}
+				database.Filters = append(database.Filters, &pb.Filter{Column: filter.Column, Op: filter.Op, Value: nc.FormatValue(filter.Value)})
+			}
+			database.OrderBy = flagValue("--order-by")
+			database.OrderDescending = hasFlag("--descending")
+			if _, err := nc.GetDatabaseDriver(database.DbType); err != nil {
+				fmt.Println("Failed to publish database table:", err)
 				os.Exit(1)
 			}
-			database := nc.CreateDatabaseTable(os.Args[4], os.Args[5], int32(port), os.Args[7], os.Args[8])
+			if !hasFlag("--no-verify") {
+				if err := nc.VerifyDatabaseTable(database); err != nil {
+					fmt.Println("Failed to publish database table:", err)
+					os.Exit(1)
+				}
+			}
 			err = client.PublishDatabaseTable(path, database)
 			if err != nil {
 				fmt.Println("Failed to publish database table:", err)
 				os.Exit(1)
 			}
 		case "event":
-			eventStream := nc.CreateEventStream(os.Args[4])
-			if len(os.Args) > 5 {
-				eventStream.Server = os.Args[5]
+			args := positionalArgs()
+			eventStream := nc.CreateEventStream(args[4])
+			eventStream.DefaultGroupId = flagValue("--group")
+			parseStreamSettings(eventStream)
+			if len(args) > 5 {
+				eventStream.Brokers = nc.ParseBrokers(args[5])
+			} else if eventStream.Transport != "" && eventStream.Transport != nc.TransportKafka {
+				// The brokers in the environment are Kafka's
+				eventStream.Brokers = nil
 			}
 			err = client.PublishEventStream(path, eventStream)
 			if err != nil {
 				fmt.Println("Failed to publish event stream:", err)
 				os.Exit(1)
 			}
+		case "query":
+			args := positionalArgs()
+			if len(args) < 6 {
+				fmt.Println(`Usage: nexus-client publish query <path> <connection_path> <sql> [--param=<name>:<type>[=<default>]]
+
+			The SQL statement refers to parameters as :name. Each parameter must be declared with
+			--param, where the type is one of string, int, float or bool. Parameters without a
+			default must be given a value when the query is consumed with --param=<name>=<value>.`)
+				os.Exit(1)
+			}
+			query := nc.CreateQuery(args[4], args[5])
+			for _, decl := range flagValues("--param") {
+				parameter, err := nc.ParseQueryParameter(decl)
+				if err != nil {
+					fmt.Println("Failed to parse query parameter:", err)
+					os.Exit(1)
+				}
+				query.Parameters = append(query.Parameters, parameter)
+			}
+			if err := nc.ValidateQuery(query); err != nil {
+				fmt.Println("Failed to publish query:", err)
+				os.Exit(1)
+			}
+			err = client.PublishQuery(path, query)
+			if err != nil {
+				fmt.Println("Failed to publish query:", err)
+				os.Exit(1)
+			}
 		case "value":
 			err = client.PublishValue(path, os.Args[4])
 			if err != nil {
@@ -102,16 +547,49 @@ func main() {
 				os.Exit(1)
 			}
 		default:
-			fmt.Println("Unknown publish type. Use 'file', 'directory', 'DBTable', 'event', or 'value'.")
+			fmt.Println("Unknown publish type. Use 'file', 'directory', 'DBTable', 'query', 'event', or 'value'.")
 			os.Exit(1)
 		}
 	case "consume":
 		var path string
-		if len(os.Args) < 2 {
+		if len(os.Args) < 3 {
 			path = "/" // Default to root if no path is provided
 		} else {
 			path = os.Args[2]
 		}
+		dirOptions := nc.DirectoryReadOptions{
+			Pattern:      flagValue("--glob"),
+			SourceColumn: flagValue("--source-column"),
+			Partitioned:  hasFlag("--partitioned"),
+		}
+		if hash := flagValue("--if-changed"); hash != "" || hasFlag("--fingerprint") {
+			// Read through the server, skipping the read if the contents still have the given hash
+			cursor, fingerprint, err := client.ReadDatasetIfChanged(path, hash, parseReadOptions(), dirOptions)
+			if fingerprint != nil {
+				fmt.Println("Fingerprint:", fingerprint.Hash)
+			}
+			if errors.Is(err, nc.ErrNotModified) {
+				fmt.Println("Not modified")
+				return
+			}
+			if err != nil {
+				fmt.Println("Failed to read dataset:", err)
+				os.Exit(1)
+			}
+			printCursor(cursor)
+			return
+		}
+		if hasFlag("--remote") {
+			// Let the server read the dataset and stream the rows back
+			cursor, err := client.ReadDataset(path, parseReadOptions(), dirOptions)
+			if err != nil {
+				fmt.Println("Failed to read dataset:", err)
+				os.Exit(1)
+			}
+			printCursor(cursor)
+			return
+		}
+
 		data, err := client.Get(path)
 		if err != nil {
 			fmt.Println("Failed to consume value:", err)
@@ -129,28 +607,85 @@ func main() {
 			fmt.Printf("%d\n", v.Value)
 		case *pb.FloatValue:
 			fmt.Printf("%.2f\n", v.Value)
+		case *pb.BoolValue:
+			fmt.Printf("%t\n", v.Value)
 		case *pb.DatabaseTable:
 			fmt.Printf("Consumed dataset: %v\n", v.TableName)
-			fmt.Println(nc.QueryTable(v))
+			cursor, err := nc.OpenDatabaseTable(v, parseReadOptions())
+			if err != nil {
+				fmt.Println("Failed to query table:", err)
+				os.Exit(1)
+			}
+			printCursor(cursor)
+		case *pb.Query:
+			fmt.Printf("Consumed query: %v\n", v.Sql)
+			connection, err := client.GetQueryConnection(v)
+			if err != nil {
+				fmt.Println("Failed to get query connection:", err)
+				os.Exit(1)
+			}
+			cursor, err := nc.OpenQuery(v, connection, parseReadOptions())
+			if err != nil {
+				fmt.Println("Failed to run query:", err)
+				os.Exit(1)
+			}
+			printCursor(cursor)
 		case *pb.IndividualFile:
 			fmt.Printf("Consumed dataset: %v\n", v.FilePath)
-			fileStr, err := nc.ReadFile(v.FilePath)
+			cursor, err := nc.OpenIndividualFile(v, parseReadOptions())
 			if err != nil {
 				fmt.Println("Failed to read file:", err)
 				os.Exit(1)
 			}
-			fmt.Println(fileStr)
+			printCursor(cursor)
 		case *pb.Directory:
 			fmt.Printf("Consumed dataset: %v\n", v.DirectoryPath)
+			cursor, err := nc.OpenDirectory(v, dirOptions, parseReadOptions())
+			if err != nil {
+				fmt.Println("Failed to read directory:", err)
+				os.Exit(1)
+			}
+			printCursor(cursor)
 		case *pb.EventStream:
 			fmt.Printf("Consumed dataset: %v\n", v.Topic)
-			messageChan, err := nc.GetEventStream(v)
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			options := parseStreamOptions()
+			var subscription *nc.Subscription
+			if hasFlag("--relay") {
+				// Let the server consume the topic, for when the brokers can't be reached
+				subscription, err = client.RelayEventStream(ctx, path, options)
+			} else {
+				subscription, err = nc.SubscribeEventStream(ctx, v, options)
+			}
 			if err != nil {
 				fmt.Println("Failed to get event stream:", err)
 				os.Exit(1)
 			}
-			for message := range messageChan {
-				fmt.Println(string(message))
+			go func() {
+				for err := range subscription.Errors() {
+					fmt.Fprintln(os.Stderr, "Error consuming event stream:", err)
+				}
+			}()
+			// Values of streams without a declared encoding are printed as they are
+			var decoder nc.Decoder
+			if v.Encoding != "" && !hasFlag("--raw") {
+				if decoder, err = nc.NewDecoder(v); err != nil {
+					fmt.Fprintln(os.Stderr, "Failed to decode event stream, printing raw values:", err)
+				}
+			}
+			var fields []string
+			if value := flagValue("--fields"); value != "" {
+				fields = strings.Split(value, ",")
+			}
+			for message := range subscription.Messages() {
+				printMessage(message, decoder, fields, hasFlag("--metadata"))
+				if options.CommitMode == nc.CommitManual {
+					// Commit each message once it has been printed
+					if err := subscription.Commit(); err != nil {
+						fmt.Fprintln(os.Stderr, "Failed to commit offsets:", err)
+					}
+				}
 			}
 		default:
 			//fmt.Printf("Unknown data type %s", reflect.TypeOf(v).Elem().Name())
@@ -170,10 +705,59 @@ func main() {
 		}
 		fmt.Printf("Children of path '%s':\n", path)
 		for _, child := range children {
-			fmt.Println(path + "/" + child.Name + "<" + child.Type + ">")
+			line := path + "/" + child.Name + "<" + child.Type + ">"
+			if child.Health != nil {
+				line += " [" + child.Health.Status
+				if child.Health.LastError != "" {
+					line += ": " + child.Health.LastError
+				}
+				line += "]"
+			}
+			fmt.Println(line)
+		}
+	case "preview":
+		if len(os.Args) < 3 {
+			fmt.Println("Usage: nexus-client preview <path> [--refresh]")
+			os.Exit(1)
+		}
+		preview, err := client.GetPreview(os.Args[2], hasFlag("--refresh"))
+		if err != nil {
+			fmt.Println("Failed to get preview:", err)
+			os.Exit(1)
+		}
+		printPreview(preview)
+	case "secrets":
+		runSecrets()
+	case "watch-dir":
+		runWatchDir(client)
+	case "lineage":
+		runLineage(client)
+	case "export":
+		runExport(client)
+	case "produce":
+		runProduce(client)
+	case "subscribe":
+		if len(os.Args) < 3 {
+			fmt.Println("Usage: nexus-client subscribe <path> [--recursive]")
+			os.Exit(1)
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		events, err := client.Subscribe(ctx, os.Args[2], hasFlag("--recursive"))
+		if err != nil {
+			fmt.Println("Failed to subscribe:", err)
+			os.Exit(1)
+		}
+		for event := range events {
+			line := fmt.Sprintf("%s %s %s", time.UnixMilli(event.Timestamp).Format(time.RFC3339), event.Type, event.Path)
+			if event.Fingerprint != nil {
+				line += " " + event.Fingerprint.Hash
+			}
+			fmt.Println(line)
 		}
 	default:
-		fmt.Println("Unknown command. Use 'publish' or 'consume'.")
+		fmt.Println("Unknown command:", os.Args[1])
+		printUsage()
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	nc "nexus/pkg/client"
+)
+
+// maxProduceLine is the longest line read from stdin as a single message
+const maxProduceLine = 10 * 1024 * 1024
+
+// runProduce sends each line read from stdin as a message to an event stream
+func runProduce(client *nc.NexusClient) {
+	args := positionalArgs()
+	if len(args) < 3 {
+		fmt.Println(`Usage: nexus-client produce <path> [--key=<key>] [--key-separator=<sep>] [--header=<name>=<value>]... [--async]
+
+			Sends each line read from stdin as a message to the event stream registered at <path>.
+
+			Options:
+			--key=<key>           - Key of every message
+			--key-separator=<sep> - Split each line at the first <sep> into the key and the value
+			--header=<name>=<v>   - Header added to every message, may be repeated
+			--async               - Don't wait for each message to be acknowledged before sending the next
+			--batch-size=<n>      - Send messages in batches of n
+			--batch-bytes=<n>     - Send messages in batches of n bytes
+			--linger=<time>       - Longest a batch waits to fill, such as 50ms
+			--report              - Print the partition and offset of each message delivered`)
+		os.Exit(1)
+	}
+	path := args[2]
+
+	var headers []nc.Header
+	for _, header := range flagValues("--header") {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok {
+			fmt.Println("Invalid header, expected --header=<name>=<value>:", header)
+			os.Exit(1)
+		}
+		headers = append(headers, nc.Header{Key: name, Value: []byte(value)})
+	}
+
+	var delivered, failed atomic.Int64
+	report := hasFlag("--report")
+	options := nc.ProducerOptions{
+		Mode:       nc.ProduceSync,
+		BatchSize:  intFlag("--batch-size"),
+		BatchBytes: intFlag("--batch-bytes"),
+		OnDelivery: func(delivery nc.Delivery) {
+			if delivery.Err != nil {
+				failed.Add(1)
+				fmt.Fprintln(os.Stderr, "Failed to deliver message:", delivery.Err)
+				return
+			}
+			delivered.Add(1)
+			if report {
+				fmt.Printf("%d:%d\n", delivery.Partition, delivery.Offset)
+			}
+		},
+	}
+	if hasFlag("--async") {
+		options.Mode = nc.ProduceAsync
+	}
+	if value := flagValue("--linger"); value != "" {
+		linger, err := time.ParseDuration(value)
+		if err != nil {
+			fmt.Println("Failed to parse --linger:", err)
+			os.Exit(1)
+		}
+		options.Linger = linger
+	}
+	if err := client.SetProducerOptions(options); err != nil {
+		fmt.Println("Invalid producer options:", err)
+		os.Exit(1)
+	}
+
+	key := flagValue("--key")
+	separator := flagValue("--key-separator")
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 64*1024), maxProduceLine)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		var messageKey []byte
+		if key != "" {
+			messageKey = []byte(key)
+		}
+		if separator != "" {
+			if k, v, ok := strings.Cut(line, separator); ok {
+				messageKey, line = []byte(k), v
+			}
+		}
+		delivery, err := client.Produce(path, messageKey, []byte(line), headers)
+		if err != nil && delivery.Topic == "" {
+			// The stream couldn't be resolved or produced to, rather than this message failing
+			fmt.Println("Failed to produce message:", err)
+			os.Exit(1)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to read stdin:", err)
+	}
+
+	client.CloseProducers()
+	fmt.Fprintf(os.Stderr, "Produced %d messages to %s\n", delivered.Load(), path)
+	if failed.Load() > 0 {
+		fmt.Fprintf(os.Stderr, "%d messages failed\n", failed.Load())
+		os.Exit(1)
+	}
+}
+
+// intFlag returns the value of an integer flag, or zero if it wasn't passed
+func intFlag(flag string) int {
+	value := flagValue(flag)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		fmt.Printf("Failed to parse %s: %v\n", flag, err)
+		os.Exit(1)
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	nc "nexus/pkg/client"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// stdin is shared so that consecutive prompts read consecutive lines of piped input
+var stdin = bufio.NewReader(os.Stdin)
+
+// runSecrets manages the credentials stored in the local encrypted secrets file
+func runSecrets() {
+	if len(os.Args) < 3 {
+		fmt.Println(`Usage: nexus-client secrets set|delete|list
+
+			set <name> <username> - Store a credential, reading the password from the terminal or stdin
+			delete <name>         - Remove a credential
+			list                  - List the stored credential names and usernames
+
+			The secrets file is encrypted with the passphrase in $NEXUS_SECRETS_KEY and stored at
+			$NEXUS_SECRETS_FILE, or nexus/secrets.enc in the user's config directory.
+			Reference a stored credential when publishing a table with --credential=file:<name>.`)
+		os.Exit(1)
+	}
+
+	passphrase := os.Getenv(nc.SecretsKeyEnv)
+	if passphrase == "" {
+		passphrase = readSecret("Secrets key: ")
+	}
+
+	switch os.Args[2] {
+	case "set":
+		if len(os.Args) < 5 {
+			fmt.Println("Usage: nexus-client secrets set <name> <username>")
+			os.Exit(1)
+		}
+		password := readSecret("Password: ")
+		err := nc.SetSecret(os.Args[3], nc.Credential{Username: os.Args[4], Password: password}, passphrase)
+		if err != nil {
+			fmt.Println("Failed to store credential:", err)
+			os.Exit(1)
+		}
+		fmt.Println("Stored credential:", os.Args[3])
+	case "delete":
+		if len(os.Args) < 4 {
+			fmt.Println("Usage: nexus-client secrets delete <name>")
+			os.Exit(1)
+		}
+		if err := nc.DeleteSecret(os.Args[3], passphrase); err != nil {
+			fmt.Println("Failed to delete credential:", err)
+			os.Exit(1)
+		}
+		fmt.Println("Deleted credential:", os.Args[3])
+	case "list":
+		names, usernames, err := nc.ListSecrets(passphrase)
+		if err != nil {
+			fmt.Println("Failed to list credentials:", err)
+			os.Exit(1)
+		}
+		for _, name := range names {
+			fmt.Printf("%s (%s)\n", name, usernames[name])
+		}
+	default:
+		fmt.Println("Unknown secrets command. Use 'set', 'delete' or 'list'.")
+		os.Exit(1)
+	}
+}
+
+// readSecret reads a line from stdin without echoing it when stdin is a terminal
+func readSecret(prompt string) string {
+	if term.IsTerminal(os.Stdin.Fd()) {
+		fmt.Fprint(os.Stderr, prompt)
+		secret, err := term.ReadPassword(os.Stdin.Fd())
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			fmt.Println("Failed to read input:", err)
+			os.Exit(1)
+		}
+		return string(secret)
+	}
+
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		fmt.Println("Failed to read input:", err)
+		os.Exit(1)
+	}
+	return strings.TrimRight(line, "\r\n")
+}
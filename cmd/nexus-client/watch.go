@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	nc "nexus/pkg/client"
+)
+
+// runWatchDir keeps the files of local directories registered until interrupted
+func runWatchDir(client *nc.NexusClient) {
+	args := positionalArgs()
+	config := flagValue("--config")
+	if len(args) < 4 && config == "" {
+		fmt.Println(`Usage: nexus-client watch-dir <local_dir> <trie_prefix> [--directory=<path>] [--infer]
+			       nexus-client watch-dir --config=<file> [--infer]
+
+			Registers every file below <local_dir> under <trie_prefix>, followed by its relative
+			path, and keeps the registrations current as files are added, changed and removed.
+
+			Options:
+			--directory=<path> - Also keep a Directory node for <local_dir> current at <path>
+			--config=<file>    - Watch the directories listed in a JSON file, as
+			                     [{"dir": ..., "prefix": ..., "directory_path": ...}, ...]
+			--infer            - Infer the schema of files and directories before registering them`)
+		os.Exit(1)
+	}
+
+	var watches []nc.DirectoryWatch
+	if config != "" {
+		data, err := os.ReadFile(config)
+		if err != nil {
+			fmt.Println("Failed to read watch config:", err)
+			os.Exit(1)
+		}
+		if err := json.Unmarshal(data, &watches); err != nil {
+			fmt.Println("Failed to parse watch config:", err)
+			os.Exit(1)
+		}
+	}
+	if len(args) >= 4 {
+		watches = append(watches, nc.DirectoryWatch{Dir: args[2], Prefix: args[3], DirectoryPath: flagValue("--directory")})
+	}
+
+	watcher, err := nc.NewWatcher(client, watches...)
+	if err != nil {
+		fmt.Println("Failed to watch directories:", err)
+		os.Exit(1)
+	}
+	watcher.Infer = hasFlag("--infer")
+	watcher.OnChange = func(action string, path string) {
+		fmt.Printf("%s %s\n", action, path)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	for _, watch := range watches {
+		fmt.Printf("Watching %s as %s\n", watch.Dir, watch.Prefix)
+	}
+	if err := watcher.Run(ctx); err != nil {
+		fmt.Println("Failed to watch directories:", err)
+		os.Exit(1)
+	}
+}
@@ -20,6 +20,10 @@ func printHelp() {
 	fmt.Println("  --new-index        Create a new index.")
 	fmt.Println("<load_file_path>   Path to the file to load.")
 	fmt.Println("<save_file_path>   Path to the file to save.")
+	fmt.Println("Environment:")
+	fmt.Println("  NEXUS_DATA_ROOTS   Directories whose files the server may read for clients, separated like $PATH.")
+	fmt.Println("  NEXUS_DATA_HOSTS   Database and authenticated event stream hosts the server may connect to, comma separated host or host:port.")
+	fmt.Println("  NEXUS_RELAY_GROUPS Consumer groups relayed event streams may be consumed in, comma separated.")
 }
 
 func main() {
@@ -81,6 +85,15 @@ func main() {
 		}
 	}
 
+	// Keep dataset previews and statistics fresh in the background
+	server.Previews.Start()
+
+	// Probe the registered files, databases and streams in the background
+	server.Health.Start()
+
+	// Keep the last values of event streams with a last value cache
+	server.LastValues.Start()
+
 	// Channel to listen for interrupt signals
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
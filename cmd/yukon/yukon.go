@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"nexus/pkg/logger"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -18,6 +20,23 @@ import (
 	"github.com/charmbracelet/lipgloss/v2"
 )
 
+// streamOptions controls where event streams start consuming, set from the command line.
+// Browsing a stream never joins its default consumer group.
+var streamOptions = nc.StreamOptions{Standalone: true}
+
+// relayStreams consumes event streams through the server rather than from the Kafka brokers
+var relayStreams bool
+
+// streamFields are the fields of decoded messages shown, as columns when viewing a stream, set
+// from the command line. The fields of a stream's first message are shown if none are set.
+var streamFields []string
+
+// streamViewRows is the number of latest messages shown when viewing an event stream
+const streamViewRows = 100
+
+// streamViewFields is the most fields taken from a stream's first message as columns
+const streamViewFields = 8
+
 var baseStyle = lipgloss.NewStyle().
 	BorderStyle(lipgloss.NormalBorder()).
 	BorderForeground(lipgloss.Color("240"))
@@ -35,9 +54,17 @@ type model struct {
 	searchInput   textinput.Model
 	isSearching   bool
 	streamingData bool
-	showPopup     bool
-	popupType     string // "add" or "delete"
-	popupInput    textinput.Model
+	// Subscriptions to the event streams shown, closed when leaving them
+	streams []*nc.Subscription
+	// Fields shown as columns of the event stream viewed, if any
+	viewFields []string
+	showPopup  bool
+	popupType  string // "add" or "delete"
+	popupInput textinput.Model
+	// Paths that would break if the path selected for deletion were deleted
+	deleteDependents []string
+	// Path whose lineage is shown instead of the children of path, if any
+	lineagePath string
 	// New fields for type selection and form
 	selectedType    int
 	showTypeSelect  bool
@@ -70,8 +97,9 @@ var formFields = map[string][]string{
 		"Value",
 	},
 	"Event Stream": {
-		"Server",
+		"Brokers",
 		"Topic",
+		"Transport",
 	},
 	"File": {
 		"File Path",
@@ -93,14 +121,46 @@ var formFields = map[string][]string{
 type rowDataMsg struct {
 	rows    []table.Row
 	message string
+	streams []*nc.Subscription // Subscriptions to the event streams among the rows
 }
 
 type streamDataMsg struct {
-	channel <-chan []byte
+	stream  *nc.Subscription
+	decoder nc.Decoder
 	row     table.Row
 	label   string
 	rowNum  int
 	message string
+	closed  bool // The subscription ended, so no more messages follow
+}
+
+// streamViewMsg opens the view of an event stream, listing its latest messages
+type streamViewMsg struct {
+	stream  *nc.Subscription
+	decoder nc.Decoder
+}
+
+// streamRecordMsg carries the next message of the event stream viewed
+type streamRecordMsg struct {
+	stream  *nc.Subscription
+	decoder nc.Decoder
+	record  *nc.Record
+	closed  bool // The subscription ended, so no more messages follow
+}
+
+type previewMsg struct {
+	columns []table.Column
+	rows    []table.Row
+}
+
+type lineageMsg struct {
+	path string
+	rows []table.Row
+}
+
+type dependentsMsg struct {
+	path       string
+	dependents []string
 }
 
 type errMsg struct {
@@ -130,13 +190,8 @@ type deletePathResponse struct {
 func initialModel(initialPath, host string, port int) model {
 	log := logger.GetLogger()
 
-	columns := []table.Column{
-		{Title: "Path", Width: 40},
-		{Title: "Type", Width: 20},
-	}
-
 	t := table.New(
-		table.WithColumns(columns),
+		table.WithColumns(pathColumns()),
 		table.WithFocused(true),
 		table.WithHeight(10),
 	)
@@ -205,6 +260,45 @@ func initialModel(initialPath, host string, port int) model {
 	}
 }
 
+// pathColumns returns the columns of the table listing the children of a path
+func pathColumns() []table.Column {
+	return []table.Column{
+		{Title: "Path", Width: 40},
+		{Title: "Type", Width: 20},
+		{Title: "Health", Width: 20},
+	}
+}
+
+// lineageColumns returns the columns of the table listing the lineage of a path
+func lineageColumns() []table.Column {
+	return []table.Column{
+		{Title: "Path", Width: 40},
+		{Title: "Relation", Width: 14},
+		{Title: "Type", Width: 16},
+		{Title: "Health", Width: 20},
+	}
+}
+
+// healthColors maps each health status to the colour of its marker
+var healthColors = map[string]string{
+	nc.HealthHealthy:   "2", // Green
+	nc.HealthDegraded:  "3", // Yellow
+	nc.HealthUnhealthy: "1", // Red
+}
+
+// healthMarker renders a coloured marker for a child's health. Values and internal nodes
+// have no health, and resources not checked yet are shown in grey.
+func healthMarker(health *pb.HealthStatus) string {
+	if health == nil {
+		return ""
+	}
+	color, ok := healthColors[health.Status]
+	if !ok {
+		color = "8" // Grey
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render("●") + " " + health.Status
+}
+
 func (m model) Init() (tea.Model, tea.Cmd) {
 	if m.initPath != "/" {
 		return m, moveDownCmd(m.client, m.initPath, m.isLeafNode)
@@ -224,6 +318,7 @@ func fetchRowsCmd(client *nc.NexusClient, path string) tea.Cmd {
 	}
 
 	rows := []table.Row{}
+	var streams []*nc.Subscription
 	for index, child := range children {
 		log.Info("Fetching data", "path", path+child.Name)
 		data, dataType, err := client.GetFull(path + child.Name)
@@ -258,12 +353,18 @@ func fetchRowsCmd(client *nc.NexusClient, path string) tea.Cmd {
 		case *pb.FloatValue:
 			valueStr := fmt.Sprintf("%f", v.Value)
 			rows = append(rows, table.Row{child.Name, valueStr})
+		case *pb.BoolValue:
+			valueStr := fmt.Sprintf("%t", v.Value)
+			rows = append(rows, table.Row{child.Name, valueStr})
 		case *pb.StringValue:
 			valueStr := v.Value
 			rows = append(rows, table.Row{child.Name, valueStr})
 		case *pb.DatabaseTable:
 			valueStr := fmt.Sprintf("DatabaseTable: %s", v.TableName)
 			rows = append(rows, table.Row{child.Name, valueStr})
+		case *pb.Query:
+			valueStr := fmt.Sprintf("Query: %s", v.Sql)
+			rows = append(rows, table.Row{child.Name, valueStr})
 		case *pb.Directory:
 			valueStr := fmt.Sprintf("Directory: %s", v.DirectoryPath)
 			rows = append(rows, table.Row{child.Name, valueStr})
@@ -271,30 +372,32 @@ func fetchRowsCmd(client *nc.NexusClient, path string) tea.Cmd {
 			valueStr := fmt.Sprintf("File (%s): %s", v.FileType, v.FilePath)
 			rows = append(rows, table.Row{child.Name, valueStr})
 		case *pb.EventStream:
-			log.Info("Event Stream: ", "server", v.Server, "topic", v.Topic)
+			log.Info("Event Stream: ", "brokers", v.Brokers, "topic", v.Topic)
 
-			messageChan, err := nc.GetEventStream(v)
+			stream, err := subscribeStream(client, path+child.Name, v)
 			if err != nil {
 				log.Error("Failed to get event stream", "error", err)
 				os.Exit(1)
 			}
 
-			log.Debug("Stream initialized", "channel", messageChan)
+			log.Debug("Stream initialized", "topic", v.Topic)
+			streams = append(streams, stream)
 
 			rows = append(rows, table.Row{child.Name, "Waiting for messages..."})
 
-			cmds = append(cmds, processStream(messageChan, child.Name, index, "streamInit"))
+			cmds = append(cmds, processStream(stream, streamDecoder(v), child.Name, index, "streamInit"))
 
 		default:
 			log.Debug("Unknown data type", "type", dataType)
 			valueStr := fmt.Sprintf("value: %v, has unknown type: %T", v, v)
 			rows = append(rows, table.Row{child.Name, valueStr})
 		}
+		rows[len(rows)-1] = append(rows[len(rows)-1], healthMarker(child.Health))
 
-		//return rowDataMsg{rows, "fetchData"}
+		//return rowDataMsg{rows: rows, message: "fetchData"}
 	}
 	cmds = append(cmds, func() tea.Msg {
-		return rowDataMsg{rows, "fetchData"}
+		return rowDataMsg{rows: rows, message: "fetchData", streams: streams}
 	})
 	return tea.Batch(cmds...)
 }
@@ -317,7 +420,7 @@ func fetchChildrenCmd(client *nc.NexusClient, path string) tea.Cmd {
 		sort.Slice(rows, func(i, j int) bool {
 			return rows[i][0] < rows[j][0]
 		})
-		return rowDataMsg{rows, "fetchChildren"}
+		return rowDataMsg{rows: rows, message: "fetchChildren"}
 	}
 }
 
@@ -345,12 +448,18 @@ func fetchDataCmd(client *nc.NexusClient, path string) tea.Cmd {
 		case *pb.FloatValue:
 			valueStr := fmt.Sprintf("%f", v.Value)
 			rows = append(rows, table.Row{path, valueStr})
+		case *pb.BoolValue:
+			valueStr := fmt.Sprintf("%t", v.Value)
+			rows = append(rows, table.Row{path, valueStr})
 		case *pb.StringValue:
 			valueStr := v.Value
 			rows = append(rows, table.Row{path, valueStr})
 		case *pb.DatabaseTable:
 			valueStr := fmt.Sprintf("DatabaseTable: %s", v.TableName)
 			rows = append(rows, table.Row{path, valueStr})
+		case *pb.Query:
+			valueStr := fmt.Sprintf("Query: %s", v.Sql)
+			rows = append(rows, table.Row{path, valueStr})
 		case *pb.Directory:
 			valueStr := fmt.Sprintf("Directory: %s", v.DirectoryPath)
 			rows = append(rows, table.Row{path, valueStr})
@@ -358,18 +467,18 @@ func fetchDataCmd(client *nc.NexusClient, path string) tea.Cmd {
 			valueStr := fmt.Sprintf("File (%s): %s", v.FileType, v.FilePath)
 			rows = append(rows, table.Row{path, valueStr})
 		case *pb.EventStream:
-			log.Info("Event Stream: ", "server", v.Server, "topic", v.Topic)
+			log.Info("Event Stream: ", "brokers", v.Brokers, "topic", v.Topic)
 
-			messageChan, err := nc.GetEventStream(v)
+			stream, err := subscribeStream(client, path, v)
 			if err != nil {
 				log.Error("Failed to get event stream", "error", err)
 				os.Exit(1)
 			}
 
-			log.Debug("Stream initialized", "channel", messageChan)
+			log.Debug("Stream initialized", "topic", v.Topic)
 
 			return streamDataMsg{
-				channel: messageChan,
+				stream:  stream,
 				row:     table.Row{"Waiting for messages..."},
 				message: "streamInit",
 			}
@@ -378,7 +487,7 @@ func fetchDataCmd(client *nc.NexusClient, path string) tea.Cmd {
 			rows = append(rows, table.Row{path, valueStr})
 		}
 
-		return rowDataMsg{rows, "fetchData"}
+		return rowDataMsg{rows: rows, message: "fetchData"}
 	}
 }
 
@@ -423,15 +532,63 @@ func filterRows(rows []table.Row, searchInput textinput.Model) []table.Row {
 	return filteredRows
 }
 
-// Function to process messages from the channel
-func processStream(messageChan <-chan []byte, label string, rowNum int, note string) tea.Cmd {
+// subscribeStream subscribes to the event stream registered at path, through the server if
+// streams are relayed
+func subscribeStream(client *nc.NexusClient, path string, es *pb.EventStream) (*nc.Subscription, error) {
+	if relayStreams {
+		return client.RelayEventStream(context.Background(), path, streamOptions)
+	}
+	return nc.SubscribeEventStream(context.Background(), es, streamOptions)
+}
+
+// streamDecoder returns the decoder of an event stream's values, falling back to showing them
+// undecoded if the stream's encoding can't be decoded
+func streamDecoder(es *pb.EventStream) nc.Decoder {
+	decoder, err := nc.NewDecoder(es)
+	if err != nil {
+		logger.GetLogger().Warn("Showing event stream values undecoded", "topic", es.Topic, "error", err)
+		decoder, _ = nc.NewDecoder(&pb.EventStream{})
+	}
+	return decoder
+}
+
+// decodeRecord decodes a message, keeping its raw value as the data if it can't be decoded
+func decodeRecord(decoder nc.Decoder, message *nc.Message) *nc.Record {
+	record, err := nc.DecodeMessage(decoder, message)
+	if err != nil {
+		logger.GetLogger().Debug("Failed to decode message", "offset", message.Offset, "error", err)
+		return &nc.Record{Message: message, Data: string(message.Value)}
+	}
+	return record
+}
+
+// formatFields formats the given fields of a record, or all of its data if none are given
+func formatFields(record *nc.Record, fields []string) string {
+	if len(fields) == 0 {
+		return nc.FormatData(record.Data)
+	}
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if value, ok := record.Field(field); ok {
+			values = append(values, field+"="+nc.FormatData(value))
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+// Function to process messages from the subscription
+func processStream(stream *nc.Subscription, decoder nc.Decoder, label string, rowNum int, note string) tea.Cmd {
 	log := logger.GetLogger()
 	return func() tea.Msg {
-		message := <-messageChan
-		log.Debug("Stream message received", "message", message)
+		message, ok := <-stream.Messages()
+		if !ok {
+			return streamDataMsg{stream: stream, closed: true}
+		}
+		log.Debug("Stream message received", "partition", message.Partition, "offset", message.Offset)
 		return streamDataMsg{
-			channel: messageChan,
-			row:     table.Row{label, string(message)},
+			stream:  stream,
+			decoder: decoder,
+			row:     table.Row{label, formatFields(decodeRecord(decoder, message), streamFields)},
 			label:   label,
 			rowNum:  rowNum,
 			message: note,
@@ -439,6 +596,141 @@ func processStream(messageChan <-chan []byte, label string, rowNum int, note str
 	}
 }
 
+// openStreamViewCmd subscribes to the event stream viewed at path
+func openStreamViewCmd(client *nc.NexusClient, path string, es *pb.EventStream) tea.Cmd {
+	log := logger.GetLogger()
+	return func() tea.Msg {
+		stream, err := subscribeStream(client, path, es)
+		if err != nil {
+			log.Error("Failed to get event stream", "path", path, "error", err)
+			return errMsg{err}
+		}
+		return streamViewMsg{stream: stream, decoder: streamDecoder(es)}
+	}
+}
+
+// readStreamRecordCmd waits for the next message of the event stream viewed
+func readStreamRecordCmd(stream *nc.Subscription, decoder nc.Decoder) tea.Cmd {
+	return func() tea.Msg {
+		message, ok := <-stream.Messages()
+		if !ok {
+			return streamRecordMsg{stream: stream, closed: true}
+		}
+		return streamRecordMsg{stream: stream, decoder: decoder, record: decodeRecord(decoder, message)}
+	}
+}
+
+// streamViewColumns returns the columns of the view of an event stream, with a column for each
+// field shown, or for the whole value if no fields are
+func streamViewColumns(fields []string) []table.Column {
+	columns := []table.Column{
+		{Title: "Partition", Width: 10},
+		{Title: "Offset", Width: 10},
+		{Title: "Key", Width: 16},
+	}
+	if len(fields) == 0 {
+		return append(columns, table.Column{Title: "Value", Width: 60})
+	}
+	for _, field := range fields {
+		columns = append(columns, table.Column{Title: field, Width: max(len(field), 16) + 2})
+	}
+	return columns
+}
+
+// streamViewRow returns the row showing a record in the view of an event stream
+func streamViewRow(record *nc.Record, fields []string) table.Row {
+	row := table.Row{
+		strconv.Itoa(int(record.Partition)),
+		strconv.FormatInt(record.Offset, 10),
+		string(record.Key),
+	}
+	if len(fields) == 0 {
+		return append(row, nc.FormatData(record.Data))
+	}
+	for _, field := range fields {
+		value, _ := record.Field(field)
+		row = append(row, nc.FormatData(value))
+	}
+	return row
+}
+
+// fetchPreviewCmd fetches the server's cached preview of the dataset at a path, or opens the
+// view of an event stream. Paths that are neither show no rows.
+func fetchPreviewCmd(client *nc.NexusClient, path string) tea.Cmd {
+	log := logger.GetLogger()
+	return func() tea.Msg {
+		if value, _, err := client.GetFull(path); err == nil {
+			if es, ok := value.(*pb.EventStream); ok {
+				return openStreamViewCmd(client, path, es)()
+			}
+		}
+		preview, err := client.GetPreview(path, false)
+		if err != nil {
+			log.Debug("No preview available", "path", path, "error", err)
+			return rowDataMsg{message: "fetchPreview"}
+		}
+
+		data := nc.PreviewTable(preview)
+		columns := make([]table.Column, len(data.Columns))
+		for i, column := range data.Columns {
+			columns[i] = table.Column{Title: column.Name, Width: len(column.Name)}
+		}
+		rows := make([]table.Row, len(data.Rows))
+		for i, values := range data.Rows {
+			row := make(table.Row, len(values))
+			for j, value := range values {
+				row[j] = nc.FormatValue(value)
+				columns[j].Width = max(columns[j].Width, min(len(row[j]), 30))
+			}
+			rows[i] = row
+		}
+		for i := range columns {
+			columns[i].Width += 2
+		}
+		return previewMsg{columns: columns, rows: rows}
+	}
+}
+
+// fetchLineageCmd fetches the paths a path is derived from and the paths derived from it
+func fetchLineageCmd(client *nc.NexusClient, path string) tea.Cmd {
+	log := logger.GetLogger()
+	return func() tea.Msg {
+		lineage, err := client.GetLineage(path, nc.LineageBoth, 0)
+		if err != nil {
+			log.Error("Failed to fetch lineage", "path", path, "error", err)
+			return errMsg{err}
+		}
+
+		rows := make([]table.Row, 0, len(lineage.Nodes))
+		for _, node := range lineage.Nodes {
+			relation := "selected"
+			if node.Depth < 0 {
+				relation = fmt.Sprintf("upstream %d", -node.Depth)
+			} else if node.Depth > 0 {
+				relation = fmt.Sprintf("downstream %d", node.Depth)
+			}
+			nodeType := node.Type
+			if node.Missing {
+				nodeType = "missing"
+			}
+			rows = append(rows, table.Row{node.Path, relation, nodeType, healthMarker(node.Health)})
+		}
+		return lineageMsg{path: path, rows: rows}
+	}
+}
+
+// fetchDependentsCmd fetches the paths that would break if a path were deleted
+func fetchDependentsCmd(client *nc.NexusClient, path string) tea.Cmd {
+	log := logger.GetLogger()
+	return func() tea.Msg {
+		dependents, err := client.GetDependents(path)
+		if err != nil {
+			log.Error("Failed to fetch dependents", "path", path, "error", err)
+		}
+		return dependentsMsg{path: path, dependents: dependents}
+	}
+}
+
 func moveUpCmd(path string) tea.Cmd {
 	log := logger.GetLogger()
 	return func() tea.Msg {
@@ -504,9 +796,11 @@ func addPathCmd(client *nc.NexusClient, path string, dataType string, popupInput
 			}
 			return addPathResponse{success: true, message: "Value added"}
 		case "Event Stream":
-			es := nc.CreateEventStream(formInputs[1].Value())
-			es.Server = formInputs[0].Value()
-			err = client.PublishEventStream(path+popupInput, es)
+			es := nc.CreateEventStream(formInputs[1].Value(), nc.ParseBrokers(formInputs[0].Value())...)
+			es.Transport = strings.ToLower(strings.TrimSpace(formInputs[2].Value()))
+			if _, err = nc.GetTransport(es.Transport); err == nil {
+				err = client.PublishEventStream(path+popupInput, es)
+			}
 		case "File":
 			file := nc.CreateIndividualFile(formInputs[0].Value())
 			err = client.PublishIndividualFile(path+popupInput, file)
@@ -553,6 +847,27 @@ func deletePathCmd(client *nc.NexusClient, path string) tea.Cmd {
 	}
 }
 
+// trackStream records a subscription to close when the view moves away from its stream
+func (m *model) trackStream(stream *nc.Subscription) {
+	if stream != nil && !slices.Contains(m.streams, stream) {
+		m.streams = append(m.streams, stream)
+	}
+}
+
+// closeStreams closes the subscriptions to the streams shown, except those kept, without
+// waiting for them
+func (m *model) closeStreams(keep ...*nc.Subscription) {
+	var kept []*nc.Subscription
+	for _, stream := range m.streams {
+		if slices.Contains(keep, stream) {
+			kept = append(kept, stream)
+			continue
+		}
+		go stream.Close()
+	}
+	m.streams = kept
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	log := logger.GetLogger()
 	var (
@@ -566,11 +881,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.path = msg.newPath
 		m.searchInput.SetValue(msg.newPath)
 		m.children = msg.children
+		m.closeStreams()
 		log.Debug("Moving down", "new_path", msg.newPath)
-		cmds = append(cmds, fetchRowsCmd(m.client, msg.newPath))
+		if msg.isLeafNode {
+			cmds = append(cmds, fetchPreviewCmd(m.client, msg.newPath))
+		} else {
+			cmds = append(cmds, fetchRowsCmd(m.client, msg.newPath))
+		}
 	case moveUpResponse:
+		if m.isLeafNode {
+			// Leaving a dataset preview, so list paths again
+			m.table.SetRows(nil)
+			m.table.SetColumns(pathColumns())
+		}
 		m.isLeafNode = false
 		m.streamingData = false
+		m.closeStreams()
 		m.path = msg.newPath
 		m.children, _ = m.client.GetChildren(msg.newPath)
 		m.searchInput.SetValue(msg.newPath)
@@ -597,15 +923,19 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	case streamDataMsg:
 		log.Debug("Stream data message received", "message", msg.message)
+		if msg.closed {
+			break
+		}
 		if msg.message == "streamInit" {
 			m.streamingData = true
+			m.trackStream(msg.stream)
 		}
 		if m.streamingData {
 			rows := m.table.Rows()
-			rows[msg.rowNum] = msg.row
+			// Keep the health marker of the stream's row
+			rows[msg.rowNum] = append(msg.row, rows[msg.rowNum][len(msg.row):]...)
 			m.table.SetRows(rows)
-			log.Debug("Continuing stream", "channel", msg.channel)
-			cmds = append(cmds, processStream(msg.channel, msg.label, msg.rowNum, "streamUpdate"))
+			cmds = append(cmds, processStream(msg.stream, msg.decoder, msg.label, msg.rowNum, "streamUpdate"))
 		}
 	case tea.KeyMsg:
 		m.lastKeyMsg = msg.String()
@@ -734,6 +1064,26 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case false:
+			if m.lineagePath != "" {
+				switch m.lastKeyMsg {
+				case "q", "ctrl+c":
+					cmds = append(cmds, tea.Quit)
+				case "enter", "l":
+					// Walk the graph to the selected path's lineage
+					if len(m.table.Rows()) > 0 {
+						cmds = append(cmds, fetchLineageCmd(m.client, m.table.SelectedRow()[0]))
+					}
+				case "esc", "backspace":
+					m.lineagePath = ""
+					m.table.SetRows(nil)
+					m.table.SetColumns(pathColumns())
+					cmds = append(cmds, fetchRowsCmd(m.client, m.path))
+				default:
+					m.table, cmd = m.table.Update(msg)
+					cmds = append(cmds, cmd)
+				}
+				break
+			}
 			switch m.lastKeyMsg {
 			case "q", "ctrl+c":
 				cmds = append(cmds, tea.Quit)
@@ -761,6 +1111,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "d":
 				m.showPopup = true
 				m.popupType = "delete"
+				m.deleteDependents = nil
+				if len(m.table.Rows()) > 0 {
+					cmds = append(cmds, fetchDependentsCmd(m.client, m.path+m.table.SelectedRow()[0]))
+				}
+			case "l":
+				if len(m.table.Rows()) > 0 && !m.isLeafNode {
+					cmds = append(cmds, fetchLineageCmd(m.client, m.path+m.table.SelectedRow()[0]))
+				}
 			default:
 				log.Debug("Default table key press", "key", m.lastKeyMsg)
 				m.table, cmd = m.table.Update(msg)
@@ -769,8 +1127,63 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	case rowDataMsg:
 		log.Debug("Row data message received", "message", msg.message, "rows", msg.rows)
+		if msg.message == "fetchData" {
+			// The rows were fetched again, along with new subscriptions to their streams, whose
+			// first messages may have arrived already
+			m.closeStreams(msg.streams...)
+			for _, stream := range msg.streams {
+				m.trackStream(stream)
+			}
+		}
+		m.rows = msg.rows
+		m.table.SetRows(m.rows)
+	case streamViewMsg:
+		if !m.isLeafNode {
+			// Left the stream before its subscription started
+			go msg.stream.Close()
+			break
+		}
+		m.trackStream(msg.stream)
+		m.viewFields = streamFields
+		m.rows = nil
+		m.table.SetRows(nil)
+		m.table.SetColumns(streamViewColumns(m.viewFields))
+		cmds = append(cmds, readStreamRecordCmd(msg.stream, msg.decoder))
+	case streamRecordMsg:
+		if msg.closed || !m.isLeafNode || !slices.Contains(m.streams, msg.stream) {
+			break
+		}
+		if m.viewFields == nil {
+			// Show the fields of the first message, if it has any
+			if fields := msg.record.Fields(); len(fields) > 0 {
+				m.viewFields = fields[:min(len(fields), streamViewFields)]
+				m.rows = nil
+				m.table.SetRows(nil)
+				m.table.SetColumns(streamViewColumns(m.viewFields))
+			}
+		}
+		m.rows = append([]table.Row{streamViewRow(msg.record, m.viewFields)}, m.rows...)
+		if len(m.rows) > streamViewRows {
+			m.rows = m.rows[:streamViewRows]
+		}
+		m.table.SetRows(m.rows)
+		cmds = append(cmds, readStreamRecordCmd(msg.stream, msg.decoder))
+	case previewMsg:
+		log.Debug("Preview message received", "columns", len(msg.columns), "rows", len(msg.rows))
 		m.rows = msg.rows
+		m.table.SetRows(nil)
+		m.table.SetColumns(msg.columns)
 		m.table.SetRows(m.rows)
+	case lineageMsg:
+		log.Debug("Lineage message received", "path", msg.path, "rows", len(msg.rows))
+		m.lineagePath = msg.path
+		m.rows = msg.rows
+		m.table.SetRows(nil)
+		m.table.SetColumns(lineageColumns())
+		m.table.SetRows(m.rows)
+		m.table.SetCursor(0)
+	case dependentsMsg:
+		m.deleteDependents = msg.dependents
 	case errMsg:
 		m.err = msg.err
 	}
@@ -799,11 +1212,18 @@ func (m model) View() string {
 	}
 
 	mainView := baseStyle.Render(
-		fmt.Sprintf("Path: %s\n\n%s\n\n%s\n\nPress q to quit, / to search, enter to navigate, backspace/esc to go up, a to add, d to delete",
+		fmt.Sprintf("Path: %s\n\n%s\n\n%s\n\nPress q to quit, / to search, enter to navigate, backspace/esc to go up, a to add, d to delete, l for lineage",
 			m.path,
 			searchBar,
 			m.table.View(),
 		))
+	if m.lineagePath != "" {
+		mainView = baseStyle.Render(
+			fmt.Sprintf("Lineage: %s\n\n%s\n\nPress q to quit, enter to follow the selected path, backspace/esc to go back",
+				m.lineagePath,
+				m.table.View(),
+			))
+	}
 
 	if m.showPopup {
 		popup := lipgloss.NewStyle().
@@ -846,7 +1266,11 @@ func (m model) View() string {
 		} else if m.popupType == "delete" {
 			if len(m.table.Rows()) > 0 {
 				selected := m.table.SelectedRow()[0]
-				popupContent = fmt.Sprintf("Delete Path\n\nAre you sure you want to delete '%s'?\n\nPress enter to confirm, esc to cancel", selected)
+				warning := ""
+				if len(m.deleteDependents) > 0 {
+					warning = fmt.Sprintf("\n\nWarning: %d paths depend on it:\n  %s", len(m.deleteDependents), strings.Join(m.deleteDependents, "\n  "))
+				}
+				popupContent = fmt.Sprintf("Delete Path\n\nAre you sure you want to delete '%s'?%s\n\nPress enter to confirm, esc to cancel", selected, warning)
 			} else {
 				popupContent = "No path selected to delete\n\nPress esc to cancel"
 			}
@@ -871,8 +1295,36 @@ func main() {
 	flag.StringVar(&initialPath, "path", "/", "Initial path to start from")
 	flag.StringVar(&host, "host", "localhost", "Host of the server")
 	flag.IntVar(&port, "port", 50051, "Port of the server")
+
+	// Add flags for where event streams start consuming
+	var offsets, since string
+	flag.StringVar(&streamOptions.Start, "from", nc.StartNewest, "Position event streams start from, newest or oldest")
+	flag.StringVar(&offsets, "offsets", "", "Offsets event stream partitions start from, as <partition>:<offset>,...")
+	flag.StringVar(&since, "since", "", "Start event streams at a timestamp, or a duration ago such as 1h")
+	flag.Int64Var(&streamOptions.LastN, "last", 0, "Start event streams this many messages before the end of each partition")
+	flag.BoolVar(&relayStreams, "relay", false, "Consume event streams through the server, for when the brokers can't be reached")
+	var fields string
+	flag.StringVar(&fields, "fields", "", "Fields of decoded event stream messages to show, as <field>,... with nested fields as a.b")
 	flag.Parse()
 
+	if fields != "" {
+		streamFields = strings.Split(fields, ",")
+	}
+
+	var err error
+	if offsets != "" {
+		if streamOptions.Offsets, err = nc.ParseOffsets(offsets); err != nil {
+			fmt.Println("Invalid -offsets:", err)
+			os.Exit(1)
+		}
+	}
+	if since != "" {
+		if streamOptions.Since, err = nc.ParseSince(since); err != nil {
+			fmt.Println("Invalid -since:", err)
+			os.Exit(1)
+		}
+	}
+
 	log.Info("Starting Yukon", "host", host, "port", port)
 	// Use host and port as needed in your application logic
 	p := tea.NewProgram(initialModel(initialPath, host, port), tea.WithKeyboardEnhancements())
@@ -3,9 +3,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"nexus/pkg/logger"
 	"os"
 	"path"
+	"path/filepath"
 	"time"
 
 	pb "nexus/pkg/proto"
@@ -18,11 +20,14 @@ const DefaultConnection = "localhost:50051" // Default connection string
 
 type NexusClient struct {
 	Client pb.NexusServiceClient
+
+	producers *producerPool // Kafka producers shared by the event streams produced to
 }
 
 func NewNexusClient(conn *grpc.ClientConn) *NexusClient {
 	return &NexusClient{
-		Client: pb.NewNexusServiceClient(conn),
+		Client:    pb.NewNexusServiceClient(conn),
+		producers: newProducerPool(),
 	}
 }
 
@@ -37,12 +42,17 @@ func CreateGRPCConnection(connStr string) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
-func CreateEventStream(topic string) *pb.EventStream {
+// CreateEventStream describes a Kafka topic on the given bootstrap brokers, or those listed in
+// $NEXUS_KAFKA_BROKERS if none are given
+func CreateEventStream(topic string, brokers ...string) *pb.EventStream {
 	log := logger.GetLogger()
-	log.Debug("Creating event stream", "topic", topic)
+	if len(brokers) == 0 {
+		brokers = ParseBrokers(os.Getenv(KafkaBrokersEnv))
+	}
+	log.Debug("Creating event stream", "topic", topic, "brokers", brokers)
 	return &pb.EventStream{
-		Server: "localhost:9092",
-		Topic:  topic,
+		Brokers: brokers,
+		Topic:   topic,
 	}
 }
 
@@ -57,26 +67,40 @@ func CreateIndividualFile(filePath string) *pb.IndividualFile {
 	}
 }
 
+// CreateDirectory describes a directory dataset, counting the files in it and in its subdirectories
+// recursively, as partitioned directories keep their files in subdirectories. Directories
+// registered before subdirectories were counted report a lower file_count until registered again.
 func CreateDirectory(directoryPath string) (*pb.Directory, error) {
 	log := logger.GetLogger()
 	log.Debug("Creating directory", "path", directoryPath)
 
-	// Get the file type from the first file in the directory
-	files, err := os.ReadDir(directoryPath)
-	if err != nil {
-		log.Error("Failed to read directory", "error", err)
-		return nil, err
-	}
-
+	// Get the file type from the first file in the directory, including files in
+	// partition subdirectories. Hidden files and directories are skipped.
 	var fileType string
+	var lastUpdated time.Time
 	fileCount := 0
-	for _, file := range files {
-		if !file.IsDir() {
+	err := filepath.WalkDir(directoryPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != directoryPath && isHiddenName(entry.Name()) {
+			return skipEntry(entry)
+		}
+		// Directories count too, as removing a file only changes the modification time of its directory
+		if info, err := entry.Info(); err == nil && info.ModTime().After(lastUpdated) {
+			lastUpdated = info.ModTime()
+		}
+		if !entry.IsDir() {
 			fileCount++
 			if fileType == "" {
-				fileType = path.Ext(file.Name())
+				fileType = path.Ext(entry.Name())
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		log.Error("Failed to read directory", "error", err)
+		return nil, err
 	}
 
 	if fileType == "" {
@@ -89,6 +113,7 @@ func CreateDirectory(directoryPath string) (*pb.Directory, error) {
 		FileType:      fileType,
 		DirectoryPath: directoryPath,
 		FileCount:     int32(fileCount),
+		LastUpdated:   lastUpdated.Unix(),
 	}, nil
 }
 
@@ -179,8 +204,12 @@ func (n *NexusClient) GetFull(path string) (interface{}, string, error) {
 		return node.GetIntValue(), valType, nil
 	case "FloatValue":
 		return node.GetFloatValue(), valType, nil
+	case "BoolValue":
+		return node.GetBoolValue(), valType, nil
 	case "DatabaseTable":
 		return node.GetDatabaseTable(), valType, nil
+	case "Query":
+		return node.GetQuery(), valType, nil
 	case "Directory":
 		return node.GetDirectory(), valType, nil
 	case "IndividualFile":
@@ -194,7 +223,15 @@ func (n *NexusClient) GetFull(path string) (interface{}, string, error) {
 	}
 }
 
+// Delete deletes a path and everything below it
 func (n *NexusClient) Delete(path string) error {
+	_, err := n.DeleteWithDependents(path)
+	return err
+}
+
+// DeleteWithDependents deletes a path and everything below it, returning the paths that
+// depended on it
+func (n *NexusClient) DeleteWithDependents(path string) ([]string, error) {
 	log := logger.GetLogger()
 	log.Debug("Deleting path", "path", path)
 
@@ -205,14 +242,14 @@ func (n *NexusClient) Delete(path string) error {
 	res, err := n.Client.DeletePath(ctx, req)
 	if err != nil {
 		log.Error("Failed to delete path", "error", err)
-		return err
+		return nil, err
 	}
 
 	if !res.Success {
 		log.Error("Failed to delete path", "error", res.Error)
-		return fmt.Errorf(res.Error)
+		return nil, fmt.Errorf(res.Error)
 	}
 
-	log.Debug("Path deleted", "path", path)
-	return nil
+	log.Debug("Path deleted", "path", path, "dependents", res.Dependents)
+	return res.Dependents, nil
 }
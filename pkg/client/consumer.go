@@ -2,16 +2,14 @@ package client
 
 import (
 	"database/sql"
-	"encoding/csv"
 	"fmt"
 	"io"
 	"nexus/pkg/logger"
-	"os"
+	"path"
+	"strings"
+	"time"
 
 	pb "nexus/pkg/proto"
-
-	"github.com/IBM/sarama"
-	_ "github.com/lib/pq"
 )
 
 // GetValue reads a single value from the specified path
@@ -30,58 +28,6 @@ func (n *NexusClient) GetValue(path string) (*pb.Value, error) {
 }
 */
 
-// GetEventStream subscribes to a Kafka topic and processes events in real-time
-func GetEventStream(es *pb.EventStream) (<-chan []byte, error) {
-	log := logger.GetLogger()
-	if es == nil {
-		log.Error("No event stream provided")
-		return nil, fmt.Errorf("no event stream provided")
-	}
-
-	log.Debug("Creating Kafka consumer", "server", es.Server, "topic", es.Topic)
-	config := sarama.NewConfig()
-	config.Consumer.Return.Errors = true
-
-	consumer, err := sarama.NewConsumer([]string{es.Server}, config)
-	if err != nil {
-		log.Error("Failed to create Kafka consumer", "error", err)
-		return nil, fmt.Errorf("failed to create Kafka consumer: %v", err)
-	}
-
-	partitionConsumer, err := consumer.ConsumePartition(es.Topic, 0, sarama.OffsetNewest)
-	if err != nil {
-		consumer.Close()
-		log.Error("Failed to create partition consumer", "error", err)
-		return nil, fmt.Errorf("failed to create partition consumer: %v", err)
-	}
-
-	// Create a channel to receive messages
-	messages := make(chan []byte)
-
-	// Start a goroutine to handle messages
-	go func() {
-		defer func() {
-			log.Debug("Closing consumer")
-			partitionConsumer.Close()
-			consumer.Close()
-			close(messages)
-		}()
-
-		for {
-			select {
-			case msg := <-partitionConsumer.Messages():
-				log.Debug("Message received", "message", msg.Value)
-				messages <- msg.Value
-			case err := <-partitionConsumer.Errors():
-				log.Error("Error consuming message", "error", err)
-			}
-		}
-	}()
-
-	log.Debug("Event stream consumer started", "server", es.Server, "topic", es.Topic)
-	return messages, nil
-}
-
 // GetDataset reads data from either a file or database table
 /*
 func (n *NexusClient) GetDataset(path string) ([][]string, error) {
@@ -114,117 +60,275 @@ func (n *NexusClient) GetDataset(path string) ([][]string, error) {
 }
 */
 
-// ReadFile reads data from a file and returns it as a slice of string slices
+// ReadFile reads data from a file with the reader for its extension and returns it as a
+// slice of string slices, with the column names as the first row
 func ReadFile(filePath string) ([][]string, error) {
 	log := logger.GetLogger()
 	log.Debug("Reading file", "path", filePath)
 
-	file, err := os.Open(filePath)
+	table, err := ReadTable(filePath, path.Ext(filePath))
 	if err != nil {
-		log.Error("Failed to open file", "error", err)
+		log.Error("Failed to read file", "error", err)
 		return nil, err
 	}
-	defer file.Close()
-
-	reader := csv.NewReader(file)
-	var records [][]string
-
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			log.Error("Failed to read record", "error", err)
-			return nil, err
-		}
-		records = append(records, record)
-	}
 
+	records := table.Strings()
 	log.Debug("File read successfully", "path", filePath, "records", len(records))
 	return records, nil
 }
 
-// generateConnectionString creates a PostgreSQL connection string from the DatabaseTable
-func generateConnectionString(table *pb.DatabaseTable, username, password string) string {
-	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
-		table.Host, table.Port, username, password, table.DbName)
-}
-
-// QueryTable executes a query on a database table and returns the results
+// QueryTable executes a query on a database table and returns the results, with the
+// column names as the first row
 func QueryTable(table *pb.DatabaseTable) ([][]string, error) {
 	log := logger.GetLogger()
 	log.Debug("Querying table", "type", table.DbType, "host", table.Host, "db", table.DbName, "table", table.TableName)
-	// Get the username from the environment variable
-	username := os.Getenv("USER") // For Unix-like systems
-	fmt.Println("using username: ", username)
-	//	if username == "" {
-	//		username = os.Getenv("USERNAME") // For Windows systems
-	//	}
-	if username == "" {
-		return nil, fmt.Errorf("could not determine the username from environment variables")
+
+	cursor, err := OpenDatabaseTable(table, ReadOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result, err := cursor.ReadAll()
+	if err != nil {
+		log.Error("Error iterating rows", "error", err)
+		return nil, err
 	}
 
-	password := os.Getenv("DB_PASSWORD") // Get the password from the environment variable
-	fmt.Println("using password: ", password)
-	if password == "" {
-		return nil, fmt.Errorf("DB_PASSWORD environment variable is not set")
+	log.Debug("Query completed successfully", "rows", len(result.Rows))
+	return result.Strings(), nil
+}
+
+// OpenDatabaseTable opens a cursor over a database table. Projection, filters, offset and
+// limit are pushed down into the query so only the requested rows leave the database.
+func OpenDatabaseTable(table *pb.DatabaseTable, options ReadOptions) (*Cursor, error) {
+	log := logger.GetLogger()
+	log.Debug("Opening table", "type", table.DbType, "host", table.Host, "db", table.DbName, "table", table.TableName)
+
+	db, driver, err := openDatabase(table)
+	if err != nil {
+		return nil, err
 	}
 
-	connStr := generateConnectionString(table, username, password)
-	db, err := sql.Open(table.DbType, connStr)
+	query, args, err := buildSelectQuery(driver, table, options)
 	if err != nil {
-		log.Error("Failed to open database connection", "error", err)
+		db.Close()
 		return nil, err
 	}
-	defer db.Close()
+	log.Debug("Executing query", "query", query)
 
-	query := fmt.Sprintf("SELECT * FROM %s limit 100", table.TableName)
-	rows, err := db.Query(query)
+	rows, err := db.Query(query, args...)
 	if err != nil {
+		db.Close()
 		log.Error("Failed to execute query", "error", err)
 		return nil, err
 	}
-	defer rows.Close()
 
-	// Get column names
-	columns, err := rows.Columns()
+	source, err := newSQLSource(db, rows)
 	if err != nil {
+		rows.Close()
+		db.Close()
 		log.Error("Failed to get column names", "error", err)
 		return nil, err
 	}
 
-	// Prepare result slice with column names as first row
-	result := [][]string{columns}
+	// The query already applied the filters and limit. Not every dialect accepts an offset
+	// without a limit, so in that case the cursor skips the rows instead.
+	cursorOptions := ReadOptions{BatchSize: options.BatchSize}
+	if options.Limit <= 0 {
+		cursorOptions.Offset = options.Offset
+	}
+	return NewCursor(source, cursorOptions)
+}
+
+// buildSelectQuery builds a query selecting the requested columns and rows of a table in
+// the driver's SQL dialect. Identifiers are quoted and filter values are passed as query
+// arguments, so neither the registered table nor the read options can inject SQL.
+func buildSelectQuery(driver DatabaseDriver, table *pb.DatabaseTable, options ReadOptions) (string, []interface{}, error) {
+	// The table's registered columns apply unless the caller asks for specific columns
+	selected := options.Columns
+	if len(selected) == 0 {
+		selected = table.Columns
+	}
+	columns := "*"
+	if len(selected) > 0 {
+		quoted := make([]string, len(selected))
+		for i, column := range selected {
+			quoted[i] = driver.QuoteIdentifier(column)
+		}
+		columns = strings.Join(quoted, ", ")
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", columns, qualifiedTableName(driver, table))
+
+	// The table's registered filters always apply in addition to the caller's
+	filters := make([]Filter, 0, len(table.Filters)+len(options.Filters))
+	for _, filter := range table.Filters {
+		filters = append(filters, Filter{Column: filter.Column, Op: filter.Op, Value: filter.Value})
+	}
+	filters = append(filters, options.Filters...)
+
+	var args []interface{}
+	var conditions []string
+	for _, filter := range filters {
+		if !isFilterOp(filter.Op) {
+			return "", nil, fmt.Errorf("unsupported filter operator: %s", filter.Op)
+		}
+		column := driver.QuoteIdentifier(filter.Column)
+		if filter.Op == "contains" {
+			args = append(args, "%"+FormatValue(filter.Value)+"%")
+			conditions = append(conditions, fmt.Sprintf("%s LIKE %s", driver.CastToText(column), driver.Placeholder(len(args))))
+			continue
+		}
+		args = append(args, filter.Value)
+		op := filter.Op
+		if op == "!=" {
+			op = "<>"
+		}
+		conditions = append(conditions, fmt.Sprintf("%s %s %s", column, op, driver.Placeholder(len(args))))
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if table.OrderBy != "" {
+		query += " ORDER BY " + driver.QuoteIdentifier(table.OrderBy)
+		if table.OrderDescending {
+			query += " DESC"
+		}
+	}
+	if options.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", options.Limit)
+		if options.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", options.Offset)
+		}
+	}
+	return query, args, nil
+}
+
+// qualifiedTableName quotes a table name, prefixed with its quoted schema name if set
+func qualifiedTableName(driver DatabaseDriver, table *pb.DatabaseTable) string {
+	if table.SchemaName == "" {
+		return driver.QuoteIdentifier(table.TableName)
+	}
+	return driver.QuoteIdentifier(table.SchemaName) + "." + driver.QuoteIdentifier(table.TableName)
+}
+
+// sqlSource streams the rows of a query result
+type sqlSource struct {
+	db      *sql.DB
+	rows    *sql.Rows
+	columns []Column
+	values  []interface{}
+	ptrs    []interface{}
+}
+
+func newSQLSource(db *sql.DB, rows *sql.Rows) (*sqlSource, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	source := &sqlSource{db: db, rows: rows, columns: make([]Column, len(columnTypes))}
+	for i, columnType := range columnTypes {
+		source.columns[i] = Column{Name: columnType.Name(), Type: sqlColumnType(columnType.DatabaseTypeName())}
+	}
 
 	// Prepare value holders
-	values := make([]interface{}, len(columns))
-	valuePtrs := make([]interface{}, len(columns))
-	for i := range columns {
-		valuePtrs[i] = &values[i]
+	source.values = make([]interface{}, len(columnTypes))
+	source.ptrs = make([]interface{}, len(columnTypes))
+	for i := range source.values {
+		source.ptrs[i] = &source.values[i]
 	}
+	return source, nil
+}
 
-	// Iterate through rows
-	for rows.Next() {
-		err := rows.Scan(valuePtrs...)
-		if err != nil {
-			log.Error("Failed to scan row", "error", err)
+func (s *sqlSource) Columns() []Column {
+	return s.columns
+}
+
+func (s *sqlSource) Next() ([]interface{}, error) {
+	if !s.rows.Next() {
+		if err := s.rows.Err(); err != nil {
 			return nil, err
 		}
+		return nil, io.EOF
+	}
+	if err := s.rows.Scan(s.ptrs...); err != nil {
+		return nil, err
+	}
 
-		// Convert values to strings
-		var record []string
-		for _, val := range values {
-			record = append(record, fmt.Sprintf("%v", val))
-		}
-		result = append(result, record)
+	row := make([]interface{}, len(s.values))
+	for i, value := range s.values {
+		row[i] = sqlValue(value, s.columns[i].Type)
 	}
+	return row, nil
+}
 
-	if err = rows.Err(); err != nil {
-		log.Error("Error iterating rows", "error", err)
+func (s *sqlSource) Close() error {
+	s.rows.Close()
+	return s.db.Close()
+}
+
+// sqlColumnType maps a database column type name to a table column type
+func sqlColumnType(typeName string) string {
+	// Drop any length or precision such as VARCHAR(20) or NUMERIC(10, 2)
+	typeName, _, _ = strings.Cut(typeName, "(")
+	switch strings.ToUpper(strings.TrimSpace(typeName)) {
+	case "INT", "INT2", "INT4", "INT8", "INTEGER", "SMALLINT", "BIGINT", "TINYINT", "MEDIUMINT", "SERIAL", "BIGSERIAL":
+		return TypeInt
+	case "FLOAT", "FLOAT4", "FLOAT8", "REAL", "DOUBLE", "DOUBLE PRECISION", "NUMERIC", "DECIMAL":
+		return TypeFloat
+	case "BOOL", "BOOLEAN":
+		return TypeBool
+	default:
+		return TypeString
+	}
+}
+
+// sqlValue converts a scanned database value to the Go type used for the column type
+func sqlValue(value interface{}, columnType string) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return convertString(string(v), columnType)
+	case string:
+		return convertString(v, columnType)
+	case int64, float64, bool:
+		return castValue(v, columnType)
+	case int32:
+		return castValue(int64(v), columnType)
+	case float32:
+		return float64(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// OpenDataset opens a cursor over the dataset registered at a path, which may be an
+// individual file, a directory, a database table or a query
+func (n *NexusClient) OpenDataset(path string, options ReadOptions) (*Cursor, error) {
+	log := logger.GetLogger()
+	log.Debug("Opening dataset", "path", path)
+
+	value, _, err := n.GetFull(path)
+	if err != nil {
 		return nil, err
 	}
 
-	log.Debug("Query completed successfully", "rows", len(result)-1)
-	return result, nil
+	switch v := value.(type) {
+	case *pb.IndividualFile:
+		return OpenIndividualFile(v, options)
+	case *pb.Directory:
+		return OpenDirectory(v, DirectoryReadOptions{}, options)
+	case *pb.DatabaseTable:
+		return OpenDatabaseTable(v, options)
+	case *pb.Query:
+		connection, err := n.GetQueryConnection(v)
+		if err != nil {
+			return nil, err
+		}
+		return OpenQuery(v, connection, options)
+	default:
+		return nil, fmt.Errorf("no dataset found at path: %s", path)
+	}
 }
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pb "nexus/pkg/proto"
+)
+
+func TestBuildSelectQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		dbType  string
+		table   *pb.DatabaseTable
+		options ReadOptions
+		want    string
+		args    []interface{}
+		wantErr bool
+	}{
+		{
+			name:   "all columns",
+			dbType: "postgres",
+			table:  &pb.DatabaseTable{TableName: "users"},
+			want:   `SELECT * FROM "users"`,
+		},
+		{
+			name:   "schema and registered columns",
+			dbType: "postgres",
+			table:  &pb.DatabaseTable{SchemaName: "app", TableName: "users", Columns: []string{"id", "name"}},
+			want:   `SELECT "id", "name" FROM "app"."users"`,
+		},
+		{
+			name:    "requested columns replace registered ones",
+			dbType:  "mysql",
+			table:   &pb.DatabaseTable{TableName: "users", Columns: []string{"id", "name"}},
+			options: ReadOptions{Columns: []string{"email"}},
+			want:    "SELECT `email` FROM `users`",
+		},
+		{
+			name:   "quotes are escaped in postgres",
+			dbType: "postgres",
+			table:  &pb.DatabaseTable{TableName: `users"; DROP TABLE x; --`},
+			want:   `SELECT * FROM "users""; DROP TABLE x; --"`,
+		},
+		{
+			name:    "backticks are escaped in mysql and sqlite",
+			dbType:  "sqlite",
+			table:   &pb.DatabaseTable{TableName: "t"},
+			options: ReadOptions{Columns: []string{"a`b"}},
+			want:    "SELECT `a``b` FROM `t`",
+		},
+		{
+			name:    "registered and requested filters are bound",
+			dbType:  "postgres",
+			table:   &pb.DatabaseTable{TableName: "t", Filters: []*pb.Filter{{Column: "active", Op: "=", Value: "true"}}},
+			options: ReadOptions{Filters: []Filter{{Column: "age", Op: "!=", Value: "30"}, {Column: "name", Op: "contains", Value: "a%"}}},
+			want:    `SELECT * FROM "t" WHERE "active" = $1 AND "age" <> $2 AND CAST("name" AS TEXT) LIKE $3`,
+			args:    []interface{}{"true", "30", "%a%%"},
+		},
+		{
+			name:    "order, limit and offset",
+			dbType:  "mysql",
+			table:   &pb.DatabaseTable{TableName: "t", OrderBy: "id", OrderDescending: true},
+			options: ReadOptions{Filters: []Filter{{Column: "id", Op: ">", Value: int64(3)}}, Limit: 10, Offset: 20},
+			want:    "SELECT * FROM `t` WHERE `id` > ? ORDER BY `id` DESC LIMIT 10 OFFSET 20",
+			args:    []interface{}{int64(3)},
+		},
+		{
+			name:    "offset without a limit is left to the cursor",
+			dbType:  "sqlite",
+			table:   &pb.DatabaseTable{TableName: "t"},
+			options: ReadOptions{Offset: 5},
+			want:    "SELECT * FROM `t`",
+		},
+		{
+			name:    "unsupported operator",
+			dbType:  "postgres",
+			table:   &pb.DatabaseTable{TableName: "t"},
+			options: ReadOptions{Filters: []Filter{{Column: "id", Op: "; DROP", Value: "1"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		driver, err := GetDatabaseDriver(tt.dbType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		query, args, err := buildSelectQuery(driver, tt.table, tt.options)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if query != tt.want || !reflect.DeepEqual(args, tt.args) {
+			t.Errorf("%s: query = %s %v, want %s %v", tt.name, query, args, tt.want, tt.args)
+		}
+	}
+}
+
+// TestOpenDatabaseTableSQLite reads a SQLite table with filters whose values would change the
+// statement if they weren't bound
+func TestOpenDatabaseTableSQLite(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "people.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	statements := []string{
+		"CREATE TABLE people (id INTEGER, name TEXT, active TEXT)",
+		"INSERT INTO people VALUES (1, 'Ada', 'true'), (2, 'Grace', 'true'), (3, 'Alan', 'false'), (4, 'Bob''; --', 'true')",
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	table := &pb.DatabaseTable{
+		DbType: "sqlite", DbName: dbPath, TableName: "people",
+		Filters: []*pb.Filter{{Column: "active", Op: "=", Value: "true"}},
+		OrderBy: "id", OrderDescending: true,
+	}
+	options := ReadOptions{Columns: []string{"name"}, Filters: []Filter{{Column: "name", Op: "!=", Value: "x' OR '1'='1"}}}
+	cursor, err := OpenDatabaseTable(table, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := cursor.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]interface{}{{"Bob'; --"}, {"Grace"}, {"Ada"}}
+	if !reflect.DeepEqual(result.Rows, want) {
+		t.Errorf("rows = %v, want %v", result.Rows, want)
+	}
+
+	table.TableName = "people; DROP TABLE people"
+	if _, err := OpenDatabaseTable(table, ReadOptions{}); err == nil {
+		t.Error("read a table named after a statement")
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM people").Scan(&count); err != nil || count != 4 {
+		t.Errorf("people table holds %d rows after reading it: %v", count, err)
+	}
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"nexus/pkg/logger"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	pb "nexus/pkg/proto"
+)
+
+// Credential is the username and password used to connect to a database
+type Credential struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// String formats the credential without its password so it can't leak into output or logs
+func (c Credential) String() string {
+	return fmt.Sprintf("{%s ********}", c.Username)
+}
+
+// GoString formats the credential without its password for %#v
+func (c Credential) GoString() string {
+	return c.String()
+}
+
+// ErrCredentialNotFound is returned by credential providers that hold no credential for a name
+var ErrCredentialNotFound = errors.New("credential not found")
+
+// CredentialProvider looks up database credentials by name
+type CredentialProvider interface {
+	Lookup(name string) (*Credential, error)
+}
+
+var (
+	credentialProvidersMu sync.RWMutex
+	credentialProviders   = map[string]CredentialProvider{}
+)
+
+// defaultCredentialSchemes are the providers searched, in order, for references without a scheme
+var defaultCredentialSchemes = []string{"file", "env"}
+
+// RegisterCredentialProvider registers a provider for credential references of the form
+// "<scheme>:<name>", replacing any provider previously registered for the scheme
+func RegisterCredentialProvider(scheme string, provider CredentialProvider) {
+	credentialProvidersMu.Lock()
+	defer credentialProvidersMu.Unlock()
+	credentialProviders[scheme] = provider
+}
+
+// GetCredentialProvider returns the provider registered for a scheme
+func GetCredentialProvider(scheme string) (CredentialProvider, error) {
+	credentialProvidersMu.RLock()
+	defer credentialProvidersMu.RUnlock()
+	provider, ok := credentialProviders[scheme]
+	if !ok {
+		return nil, fmt.Errorf("no credential provider registered for scheme: %s", scheme)
+	}
+	return provider, nil
+}
+
+func init() {
+	RegisterCredentialProvider("env", EnvCredentialProvider{})
+	RegisterCredentialProvider("file", SecretsFileProvider{})
+}
+
+// LookupCredential resolves a credential reference such as "env:ANALYTICS" or "file:prod-db".
+// References without a registered scheme are looked up in the secrets file, then the environment.
+// The secrets file is skipped for those if $NEXUS_SECRETS_KEY is unset.
+func LookupCredential(ref string) (*Credential, error) {
+	if scheme, name, ok := strings.Cut(ref, ":"); ok {
+		if provider, err := GetCredentialProvider(scheme); err == nil {
+			credential, err := provider.Lookup(name)
+			if errors.Is(err, ErrCredentialNotFound) {
+				return nil, fmt.Errorf("%w: %s", ErrCredentialNotFound, ref)
+			}
+			return credential, err
+		}
+	}
+
+	for _, scheme := range defaultCredentialSchemes {
+		provider, err := GetCredentialProvider(scheme)
+		if err != nil {
+			return nil, err
+		}
+		credential, err := provider.Lookup(ref)
+		if errors.Is(err, ErrCredentialNotFound) || errors.Is(err, ErrSecretsKeyMissing) {
+			continue
+		}
+		return credential, err
+	}
+	return nil, fmt.Errorf("%w: %s", ErrCredentialNotFound, ref)
+}
+
+// ResolveCredentials returns the credentials used to connect to a database table. Tables with a
+// credential reference use it exclusively. Otherwise credentials stored for the table's
+// "host:port" or host are used, falling back to $DB_USER (or $USER) and $DB_PASSWORD.
+func ResolveCredentials(table *pb.DatabaseTable) (*Credential, error) {
+	log := logger.GetLogger()
+
+	if table.CredentialRef != "" {
+		log.Debug("Resolving credentials", "ref", table.CredentialRef)
+		credential, err := LookupCredential(table.CredentialRef)
+		if err != nil {
+			log.Error("Failed to resolve credentials", "ref", table.CredentialRef, "error", err)
+			return nil, err
+		}
+		return credential, nil
+	}
+
+	if table.Host != "" {
+		for _, name := range []string{fmt.Sprintf("%s:%d", table.Host, table.Port), table.Host} {
+			credential, err := LookupCredential(name)
+			if err == nil {
+				log.Debug("Using host credentials", "name", name)
+				return credential, nil
+			}
+			if !errors.Is(err, ErrCredentialNotFound) {
+				log.Error("Failed to resolve credentials", "name", name, "error", err)
+				return nil, err
+			}
+		}
+	}
+
+	log.Debug("Using credentials from environment defaults")
+	return defaultEnvCredential()
+}
+
+// ErrNoScopedCredential is returned for tables without a credential reference named after their host
+var ErrNoScopedCredential = errors.New("no credential scoped to the database host")
+
+// ScopedCredential returns the credential used to connect to a table on behalf of whoever
+// registered it, such as in the server's health checks. Only the table's credential reference
+// is used, and only if it names the table's "host:port" or host, such as "env:db.internal:5432",
+// so that registering a table on another host can't have the credential sent there.
+func ScopedCredential(table *pb.DatabaseTable) (*Credential, error) {
+	if table.CredentialRef == "" || table.Host == "" {
+		return nil, ErrNoScopedCredential
+	}
+	name := table.CredentialRef
+	if scheme, rest, ok := strings.Cut(name, ":"); ok {
+		if _, err := GetCredentialProvider(scheme); err == nil {
+			name = rest
+		}
+	}
+	if name != fmt.Sprintf("%s:%d", table.Host, table.Port) && name != table.Host {
+		return nil, fmt.Errorf("%w: %s isn't named after %s", ErrNoScopedCredential, table.CredentialRef, table.Host)
+	}
+	return LookupCredential(table.CredentialRef)
+}
+
+// defaultEnvCredential reads the credential shared by tables without more specific credentials
+func defaultEnvCredential() (*Credential, error) {
+	username := os.Getenv("DB_USER")
+	if username == "" {
+		username = os.Getenv("USER") // For Unix-like systems
+	}
+	if username == "" {
+		return nil, fmt.Errorf("could not determine the username from environment variables")
+	}
+
+	password := os.Getenv("DB_PASSWORD")
+	if password == "" {
+		return nil, fmt.Errorf("DB_PASSWORD environment variable is not set")
+	}
+	return &Credential{Username: username, Password: password}, nil
+}
+
+// EnvCredentialProvider reads the credential named NAME from the NEXUS_DB_NAME_USER and
+// NEXUS_DB_NAME_PASSWORD environment variables. Characters other than letters and digits
+// in the name are replaced with underscores, so "db.internal:5432" reads
+// NEXUS_DB_DB_INTERNAL_5432_USER.
+type EnvCredentialProvider struct{}
+
+func (EnvCredentialProvider) Lookup(name string) (*Credential, error) {
+	prefix := "NEXUS_DB_" + envName(name)
+	username := os.Getenv(prefix + "_USER")
+	password := os.Getenv(prefix + "_PASSWORD")
+	if username == "" && password == "" {
+		return nil, ErrCredentialNotFound
+	}
+	return &Credential{Username: username, Password: password}, nil
+}
+
+// envName converts a credential name to the form used in environment variable names
+func envName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+)
+
+// CSVReader reads delimited text files whose first record holds the column names.
+// Column types are inferred in a first pass over the file, then rows are streamed in a second.
+type CSVReader struct {
+	Comma rune // Field delimiter, ',' if zero
+}
+
+func init() {
+	RegisterReader("csv", &CSVReader{Comma: ','})
+	RegisterReader("tsv", &CSVReader{Comma: '\t'})
+}
+
+// Open implements DatasetReader
+func (r *CSVReader) Open(filePath string) (RowSource, error) {
+	header, types, err := r.scanTypes(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	file, reader, err := r.openFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := reader.Read(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &csvSource{file: file, reader: reader, columns: stringColumns(header, types)}, nil
+}
+
+func (r *CSVReader) openFile(filePath string) (*os.File, *csv.Reader, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader := csv.NewReader(file)
+	if r.Comma != 0 {
+		reader.Comma = r.Comma
+	}
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	return file, reader, nil
+}
+
+// scanTypes reads the header and infers the type of each column from all of its values
+func (r *CSVReader) scanTypes(filePath string) ([]string, []string, error) {
+	file, reader, err := r.openFile(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("file is empty")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	header = append([]string(nil), header...)
+
+	types := make([]string, len(header))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range types {
+			if i < len(record) {
+				types[i] = mergeType(types[i], inferStringType(record[i]))
+			}
+		}
+	}
+	return header, types, nil
+}
+
+// csvSource streams the rows of a delimited text file
+type csvSource struct {
+	file    *os.File
+	reader  *csv.Reader
+	columns []Column
+}
+
+func (s *csvSource) Columns() []Column {
+	return s.columns
+}
+
+func (s *csvSource) Next() ([]interface{}, error) {
+	record, err := s.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	return stringRow(record, s.columns), nil
+}
+
+func (s *csvSource) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"encoding/csv"
+	"os"
+)
+
+// CSVWriter writes delimited text files with the column names as the first record
+type CSVWriter struct {
+	Comma rune // Field delimiter, ',' if zero
+}
+
+func init() {
+	RegisterWriter("csv", &CSVWriter{Comma: ','})
+	RegisterWriter("tsv", &CSVWriter{Comma: '\t'})
+}
+
+// Create implements DatasetWriter
+func (w *CSVWriter) Create(filePath string, columns []Column) (RowSink, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := csv.NewWriter(file)
+	if w.Comma != 0 {
+		writer.Comma = w.Comma
+	}
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+	if err := writer.Write(header); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &csvSink{file: file, writer: writer, record: make([]string, len(columns))}, nil
+}
+
+// csvSink writes rows as delimited records, formatting values as text
+type csvSink struct {
+	file   *os.File
+	writer *csv.Writer
+	record []string
+}
+
+func (s *csvSink) Write(row []interface{}) error {
+	for i := range s.record {
+		s.record[i] = ""
+		if i < len(row) {
+			s.record[i] = FormatValue(row[i])
+		}
+	}
+	return s.writer.Write(s.record)
+}
+
+func (s *csvSink) Close() error {
+	s.writer.Flush()
+	if err := s.writer.Error(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
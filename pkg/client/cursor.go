@@ -0,0 +1,318 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"nexus/pkg/logger"
+	"strings"
+)
+
+// DefaultBatchSize is the number of rows returned by each call to Cursor.Next when
+// ReadOptions.BatchSize is not set
+const DefaultBatchSize = 1000
+
+// RowSource streams the rows of a dataset one at a time
+type RowSource interface {
+	Columns() []Column
+	// Next returns the next row, or io.EOF once the source is exhausted
+	Next() ([]interface{}, error)
+	Close() error
+}
+
+// Filter is a simple predicate comparing a column with a value
+type Filter struct {
+	Column string
+	Op     string // One of =, !=, <, <=, >, >= or contains
+	Value  interface{}
+}
+
+// ReadOptions controls projection, pagination and filtering of dataset reads
+type ReadOptions struct {
+	Columns   []string // Columns to return, all columns if empty
+	Filters   []Filter // Predicates every returned row must satisfy
+	Offset    int64    // Number of matching rows to skip
+	Limit     int64    // Maximum number of rows to return, unlimited if zero
+	BatchSize int      // Number of rows per batch, DefaultBatchSize if zero
+
+	Parameters map[string]string // Values of query parameters by name, only used by queries
+}
+
+// filterOps lists the supported filter operators, longest first so that ParseFilter
+// matches "<=" before "<"
+var filterOps = []string{"contains", "!=", "<=", ">=", "=", "<", ">"}
+
+// ParseFilter parses a filter expression such as "age>=30" or "name contains Smith"
+func ParseFilter(expr string) (Filter, error) {
+	for _, op := range filterOps {
+		sep := op
+		if op == "contains" {
+			sep = " contains "
+		}
+		if column, value, ok := strings.Cut(expr, sep); ok {
+			column = strings.TrimSpace(column)
+			if column == "" {
+				break
+			}
+			return Filter{Column: column, Op: op, Value: strings.TrimSpace(value)}, nil
+		}
+	}
+	return Filter{}, fmt.Errorf("invalid filter expression: %s", expr)
+}
+
+// Cursor streams the rows of a dataset in batches, applying the read options as it goes so
+// that only one batch is held in memory at a time
+type Cursor struct {
+	source    RowSource
+	columns   []Column
+	indexes   []int // Index of each projected column in the source
+	filters   []compiledFilter
+	skip      int64
+	remaining int64 // Rows left before the limit is reached, -1 if unlimited
+	batchSize int
+}
+
+type compiledFilter struct {
+	index int
+	op    string
+	value interface{}
+}
+
+// NewCursor wraps a row source with the given read options. The cursor takes ownership of
+// the source and closes it when the cursor is closed.
+func NewCursor(source RowSource, options ReadOptions) (*Cursor, error) {
+	sourceColumns := source.Columns()
+	index := map[string]int{}
+	for i, column := range sourceColumns {
+		index[column.Name] = i
+	}
+
+	cursor := &Cursor{
+		source:    source,
+		skip:      options.Offset,
+		remaining: -1,
+		batchSize: options.BatchSize,
+	}
+	if options.Limit > 0 {
+		cursor.remaining = options.Limit
+	}
+	if cursor.batchSize <= 0 {
+		cursor.batchSize = DefaultBatchSize
+	}
+
+	if len(options.Columns) == 0 {
+		cursor.columns = sourceColumns
+	} else {
+		for _, name := range options.Columns {
+			i, ok := index[name]
+			if !ok {
+				source.Close()
+				return nil, fmt.Errorf("unknown column: %s", name)
+			}
+			cursor.columns = append(cursor.columns, sourceColumns[i])
+			cursor.indexes = append(cursor.indexes, i)
+		}
+	}
+
+	for _, filter := range options.Filters {
+		i, ok := index[filter.Column]
+		if !ok {
+			source.Close()
+			return nil, fmt.Errorf("unknown filter column: %s", filter.Column)
+		}
+		if !isFilterOp(filter.Op) {
+			source.Close()
+			return nil, fmt.Errorf("unsupported filter operator: %s", filter.Op)
+		}
+		value := filter.Value
+		if s, ok := value.(string); ok && filter.Op != "contains" {
+			value = convertString(s, sourceColumns[i].Type)
+		}
+		cursor.filters = append(cursor.filters, compiledFilter{index: i, op: filter.Op, value: value})
+	}
+
+	return cursor, nil
+}
+
+// Columns returns the columns of the rows returned by the cursor
+func (c *Cursor) Columns() []Column {
+	return c.columns
+}
+
+// Next returns the next batch of rows, or io.EOF once all rows have been returned
+func (c *Cursor) Next() ([][]interface{}, error) {
+	batch := make([][]interface{}, 0, c.batchSize)
+	for len(batch) < c.batchSize && c.remaining != 0 {
+		row, err := c.source.Next()
+		if err == io.EOF {
+			c.remaining = 0
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !c.matches(row) {
+			continue
+		}
+		if c.skip > 0 {
+			c.skip--
+			continue
+		}
+		batch = append(batch, c.project(row))
+		if c.remaining > 0 {
+			c.remaining--
+		}
+	}
+
+	if len(batch) == 0 {
+		return nil, io.EOF
+	}
+	return batch, nil
+}
+
+// ReadAll reads the remaining rows of the cursor into a table and closes it
+func (c *Cursor) ReadAll() (*Table, error) {
+	defer c.Close()
+	table := &Table{Columns: c.columns}
+	for {
+		batch, err := c.Next()
+		if err == io.EOF {
+			return table, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		table.Rows = append(table.Rows, batch...)
+	}
+}
+
+// Close releases the underlying row source
+func (c *Cursor) Close() error {
+	return c.source.Close()
+}
+
+func (c *Cursor) project(row []interface{}) []interface{} {
+	if c.indexes == nil {
+		return row
+	}
+	projected := make([]interface{}, len(c.indexes))
+	for i, index := range c.indexes {
+		projected[i] = row[index]
+	}
+	return projected
+}
+
+func (c *Cursor) matches(row []interface{}) bool {
+	for _, filter := range c.filters {
+		if !filter.matches(row[filter.index]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f compiledFilter) matches(value interface{}) bool {
+	if f.op == "contains" {
+		return value != nil && strings.Contains(FormatValue(value), FormatValue(f.value))
+	}
+	if value == nil || f.value == nil {
+		switch f.op {
+		case "=":
+			return value == nil && f.value == nil
+		case "!=":
+			return (value == nil) != (f.value == nil)
+		}
+		return false
+	}
+
+	cmp := CompareValues(value, f.value)
+	switch f.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func isFilterOp(op string) bool {
+	for _, supported := range filterOps {
+		if op == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareValues compares two table values numerically when both are numbers and
+// as formatted strings otherwise
+func CompareValues(a, b interface{}) int {
+	af, aNumeric := toFloat(a)
+	bf, bNumeric := toFloat(b)
+	if aNumeric && bNumeric {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(FormatValue(a), FormatValue(b))
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// tableSource is a row source over an in-memory table
+type tableSource struct {
+	table *Table
+	next  int
+}
+
+// NewTableSource returns a row source that streams the rows of an in-memory table
+func NewTableSource(table *Table) RowSource {
+	return &tableSource{table: table}
+}
+
+func (s *tableSource) Columns() []Column {
+	return s.table.Columns
+}
+
+func (s *tableSource) Next() ([]interface{}, error) {
+	if s.next >= len(s.table.Rows) {
+		return nil, io.EOF
+	}
+	row := s.table.Rows[s.next]
+	s.next++
+	return row, nil
+}
+
+func (s *tableSource) Close() error {
+	return nil
+}
+
+// drainSource reads all rows of a row source into a table and closes it
+func drainSource(source RowSource) (*Table, error) {
+	log := logger.GetLogger()
+	cursor, err := NewCursor(source, ReadOptions{})
+	if err != nil {
+		log.Error("Failed to create cursor", "error", err)
+		return nil, err
+	}
+	return cursor.ReadAll()
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func peopleTable() *Table {
+	return &Table{
+		Columns: []Column{{Name: "id", Type: TypeInt}, {Name: "name", Type: TypeString}, {Name: "age", Type: TypeInt}},
+		Rows: [][]interface{}{
+			{int64(1), "Ada", int64(36)},
+			{int64(2), "Grace", int64(45)},
+			{int64(3), "Alan", nil},
+			{int64(4), "Edsger", int64(72)},
+		},
+	}
+}
+
+func TestCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		options ReadOptions
+		columns []string
+		rows    [][]interface{}
+	}{
+		{
+			name:    "all rows",
+			columns: []string{"id", "name", "age"},
+			rows:    peopleTable().Rows,
+		},
+		{
+			name:    "projection reorders columns",
+			options: ReadOptions{Columns: []string{"name", "id"}},
+			columns: []string{"name", "id"},
+			rows:    [][]interface{}{{"Ada", int64(1)}, {"Grace", int64(2)}, {"Alan", int64(3)}, {"Edsger", int64(4)}},
+		},
+		{
+			name:    "filter converts text to the column type",
+			options: ReadOptions{Columns: []string{"id"}, Filters: []Filter{{Column: "age", Op: ">=", Value: "45"}}},
+			columns: []string{"id"},
+			rows:    [][]interface{}{{int64(2)}, {int64(4)}},
+		},
+		{
+			name:    "filters on nulls",
+			options: ReadOptions{Columns: []string{"id"}, Filters: []Filter{{Column: "age", Op: "=", Value: nil}}},
+			columns: []string{"id"},
+			rows:    [][]interface{}{{int64(3)}},
+		},
+		{
+			name:    "contains",
+			options: ReadOptions{Columns: []string{"name"}, Filters: []Filter{{Column: "name", Op: "contains", Value: "a"}}},
+			columns: []string{"name"},
+			rows:    [][]interface{}{{"Ada"}, {"Grace"}, {"Alan"}},
+		},
+		{
+			name:    "offset and limit apply after filtering",
+			options: ReadOptions{Columns: []string{"id"}, Filters: []Filter{{Column: "id", Op: "!=", Value: "2"}}, Offset: 1, Limit: 1},
+			columns: []string{"id"},
+			rows:    [][]interface{}{{int64(3)}},
+		},
+		{
+			name:    "offset past the end",
+			options: ReadOptions{Offset: 10},
+			columns: []string{"id", "name", "age"},
+		},
+		{
+			name:    "small batches",
+			options: ReadOptions{Columns: []string{"id"}, Limit: 3, BatchSize: 2},
+			columns: []string{"id"},
+			rows:    [][]interface{}{{int64(1)}, {int64(2)}, {int64(3)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := NewCursor(NewTableSource(peopleTable()), tt.options)
+			if err != nil {
+				t.Fatalf("NewCursor: %v", err)
+			}
+			table, err := cursor.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			if columns := table.ColumnNames(); !reflect.DeepEqual(columns, tt.columns) {
+				t.Errorf("columns = %v, want %v", columns, tt.columns)
+			}
+			if !reflect.DeepEqual(table.Rows, tt.rows) {
+				t.Errorf("rows = %v, want %v", table.Rows, tt.rows)
+			}
+		})
+	}
+}
+
+func TestCursorInvalidOptions(t *testing.T) {
+	invalid := []ReadOptions{
+		{Columns: []string{"email"}},
+		{Filters: []Filter{{Column: "email", Op: "=", Value: "x"}}},
+		{Filters: []Filter{{Column: "id", Op: "~", Value: "1"}}},
+	}
+	for _, options := range invalid {
+		if _, err := NewCursor(NewTableSource(peopleTable()), options); err == nil {
+			t.Errorf("NewCursor accepted %+v", options)
+		}
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	filters := map[string]Filter{
+		"age>=30":             {Column: "age", Op: ">=", Value: "30"},
+		"age < 30":            {Column: "age", Op: "<", Value: "30"},
+		"name!=Ada":           {Column: "name", Op: "!=", Value: "Ada"},
+		"name contains Smith": {Column: "name", Op: "contains", Value: "Smith"},
+	}
+	for expr, want := range filters {
+		if got, err := ParseFilter(expr); err != nil || got != want {
+			t.Errorf("ParseFilter(%q) = %+v, %v, want %+v", expr, got, err, want)
+		}
+	}
+	for _, expr := range []string{"=30", "age"} {
+		if _, err := ParseFilter(expr); err == nil {
+			t.Errorf("ParseFilter(%q) succeeded, want an error", expr)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package client
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"nexus/pkg/logger"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DatabaseDriver describes how to connect to and query a particular kind of database
+type DatabaseDriver interface {
+	// SQLDriverName returns the name of the database/sql driver to open connections with
+	SQLDriverName() string
+	// DSN builds the data source name used to connect to the table's database
+	DSN(table *pb.DatabaseTable, username, password string) string
+	// RequiresCredentials reports whether connections need a username and password
+	RequiresCredentials() bool
+	// QuoteIdentifier quotes a table or column name for use in a query
+	QuoteIdentifier(name string) string
+	// Placeholder returns the bind parameter for the n-th query argument, starting at 1
+	Placeholder(n int) string
+	// CastToText returns an expression converting a column to text
+	CastToText(expr string) string
+}
+
+var (
+	databaseDriversMu sync.RWMutex
+	databaseDrivers   = map[string]DatabaseDriver{}
+)
+
+// RegisterDatabaseDriver registers a driver for a database type such as "postgres",
+// replacing any driver previously registered for it
+func RegisterDatabaseDriver(dbType string, driver DatabaseDriver) {
+	databaseDriversMu.Lock()
+	defer databaseDriversMu.Unlock()
+	databaseDrivers[normalizeDBType(dbType)] = driver
+}
+
+// GetDatabaseDriver returns the driver registered for a database type
+func GetDatabaseDriver(dbType string) (DatabaseDriver, error) {
+	databaseDriversMu.RLock()
+	defer databaseDriversMu.RUnlock()
+	driver, ok := databaseDrivers[normalizeDBType(dbType)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported database type: %q", dbType)
+	}
+	return driver, nil
+}
+
+func init() {
+	RegisterDatabaseDriver("postgres", PostgresDriver{})
+	RegisterDatabaseDriver("postgresql", PostgresDriver{})
+	RegisterDatabaseDriver("mysql", MySQLDriver{})
+	RegisterDatabaseDriver("mariadb", MySQLDriver{})
+	RegisterDatabaseDriver("sqlite", SQLiteDriver{})
+	RegisterDatabaseDriver("sqlite3", SQLiteDriver{})
+}
+
+// openDatabase opens a connection to a table's database with the driver for its type
+func openDatabase(table *pb.DatabaseTable) (*sql.DB, DatabaseDriver, error) {
+	return openDatabaseWith(table, ResolveCredentials)
+}
+
+// openDatabaseWith opens the database of a table, resolving its credential with resolve if the
+// driver needs one
+func openDatabaseWith(table *pb.DatabaseTable, resolve func(*pb.DatabaseTable) (*Credential, error)) (*sql.DB, DatabaseDriver, error) {
+	log := logger.GetLogger()
+
+	driver, err := GetDatabaseDriver(table.DbType)
+	if err != nil {
+		log.Error("Failed to get database driver", "error", err)
+		return nil, nil, err
+	}
+
+	credential := &Credential{}
+	if driver.RequiresCredentials() {
+		credential, err = resolve(table)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	db, err := sql.Open(driver.SQLDriverName(), driver.DSN(table, credential.Username, credential.Password))
+	if err != nil {
+		log.Error("Failed to open database connection", "error", err)
+		return nil, nil, err
+	}
+	return db, driver, nil
+}
+
+// VerifyDatabaseTable checks that a table exists and that its registered columns, filters and
+// sort key are valid by running its query without returning any rows
+func VerifyDatabaseTable(table *pb.DatabaseTable) error {
+	return VerifyDatabaseTableContext(context.Background(), table)
+}
+
+// VerifyDatabaseTableContext is like VerifyDatabaseTable but gives up when ctx is done
+func VerifyDatabaseTableContext(ctx context.Context, table *pb.DatabaseTable) error {
+	return verifyDatabaseTable(ctx, table, ResolveCredentials)
+}
+
+// verifyDatabaseTable verifies a table, connecting with the credential resolve returns for it
+func verifyDatabaseTable(ctx context.Context, table *pb.DatabaseTable, resolve func(*pb.DatabaseTable) (*Credential, error)) error {
+	log := logger.GetLogger()
+	log.Debug("Verifying table", "type", table.DbType, "host", table.Host, "db", table.DbName, "schema", table.SchemaName, "table", table.TableName)
+
+	if table.TableName == "" {
+		return fmt.Errorf("no table name given")
+	}
+
+	db, driver, err := openDatabaseWith(table, resolve)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	query, args, err := buildSelectQuery(driver, table, ReadOptions{})
+	if err != nil {
+		return err
+	}
+	// Wrap the query so the database validates it without reading any rows
+	query = fmt.Sprintf("SELECT * FROM (%s) AS nexus_check WHERE 1 = 0", query)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Error("Failed to verify table", "table", table.TableName, "error", err)
+		return fmt.Errorf("failed to verify table %s: %v", table.TableName, err)
+	}
+	return rows.Close()
+}
+
+// IsFileDatabase reports whether a database type refers to a local database file rather
+// than a server, in which case the table's db_name holds the file path
+func IsFileDatabase(dbType string) bool {
+	driver, err := GetDatabaseDriver(dbType)
+	return err == nil && !driver.RequiresCredentials()
+}
+
+// normalizeDBType lowercases a database type such as "Postgres"
+func normalizeDBType(dbType string) string {
+	return strings.ToLower(strings.TrimSpace(dbType))
+}
+
+// PostgresDriver connects to PostgreSQL databases using lib/pq
+type PostgresDriver struct{}
+
+func (PostgresDriver) SQLDriverName() string {
+	return "postgres"
+}
+
+func (PostgresDriver) DSN(table *pb.DatabaseTable, username, password string) string {
+	params := []string{
+		"host=" + quoteDSNValue(table.Host),
+		"user=" + quoteDSNValue(username),
+		"password=" + quoteDSNValue(password),
+		"dbname=" + quoteDSNValue(table.DbName),
+		"sslmode=disable",
+	}
+	if table.Port != 0 {
+		params = append(params, fmt.Sprintf("port=%d", table.Port))
+	}
+	return strings.Join(params, " ")
+}
+
+func (PostgresDriver) RequiresCredentials() bool {
+	return true
+}
+
+func (PostgresDriver) QuoteIdentifier(name string) string {
+	return pq.QuoteIdentifier(name)
+}
+
+func (PostgresDriver) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (PostgresDriver) CastToText(expr string) string {
+	return fmt.Sprintf("CAST(%s AS TEXT)", expr)
+}
+
+// quoteDSNValue quotes a value of a PostgreSQL key=value connection string
+func quoteDSNValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
+
+// MySQLDriver connects to MySQL and MariaDB databases using go-sql-driver/mysql
+type MySQLDriver struct{}
+
+func (MySQLDriver) SQLDriverName() string {
+	return "mysql"
+}
+
+func (MySQLDriver) DSN(table *pb.DatabaseTable, username, password string) string {
+	config := mysql.NewConfig()
+	config.User = username
+	config.Passwd = password
+	config.Net = "tcp"
+	config.Addr = table.Host
+	if table.Port != 0 {
+		config.Addr = net.JoinHostPort(table.Host, strconv.Itoa(int(table.Port)))
+	}
+	config.DBName = table.DbName
+	return config.FormatDSN()
+}
+
+func (MySQLDriver) RequiresCredentials() bool {
+	return true
+}
+
+func (MySQLDriver) QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (MySQLDriver) Placeholder(n int) string {
+	return "?"
+}
+
+func (MySQLDriver) CastToText(expr string) string {
+	return fmt.Sprintf("CAST(%s AS CHAR)", expr)
+}
+
+// SQLiteDriver reads local SQLite database files using mattn/go-sqlite3. The database
+// file path is taken from the table's db_name.
+type SQLiteDriver struct{}
+
+func (SQLiteDriver) SQLDriverName() string {
+	return "sqlite3"
+}
+
+func (SQLiteDriver) DSN(table *pb.DatabaseTable, username, password string) string {
+	// Open read-only so a mistyped path fails instead of creating an empty database
+	return fmt.Sprintf("file:%s?mode=ro", table.DbName)
+}
+
+func (SQLiteDriver) RequiresCredentials() bool {
+	return false
+}
+
+func (SQLiteDriver) QuoteIdentifier(name string) string {
+	// SQLite reads an unknown double quoted identifier as a string literal, so use backticks
+	// to make misspelled columns an error
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func (SQLiteDriver) Placeholder(n int) string {
+	return "?"
+}
+
+func (SQLiteDriver) CastToText(expr string) string {
+	return fmt.Sprintf("CAST(%s AS TEXT)", expr)
+}
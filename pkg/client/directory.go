@@ -0,0 +1,459 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"nexus/pkg/logger"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "nexus/pkg/proto"
+)
+
+// DirectoryReadOptions controls how the files of a directory dataset are read
+type DirectoryReadOptions struct {
+	Pattern      string // Glob matched against file names, or relative paths if it contains a slash. Defaults to all files of the directory's type
+	SourceColumn string // Name of a column holding each row's source file, omitted if empty
+	Partitioned  bool   // Recurse into hive-style key=value subdirectories and add their keys as columns
+	Parallelism  int    // Number of files read concurrently, defaults to the number of CPUs
+}
+
+// Partition is the data read from a single file of a directory dataset
+type Partition struct {
+	File  string            // Path of the file relative to the directory
+	Keys  map[string]string // Partition keys derived from key=value subdirectory names
+	Table *Table
+}
+
+// ReadDirectoryPartitions reads every matching file of a directory dataset, returning one
+// partition per file ordered by relative path
+func ReadDirectoryPartitions(directory *pb.Directory, options DirectoryReadOptions) ([]*Partition, error) {
+	log := logger.GetLogger()
+	log.Debug("Reading directory", "path", directory.DirectoryPath, "pattern", options.Pattern, "partitioned", options.Partitioned)
+
+	partitions, err := listPartitions(directory, options)
+	if err != nil {
+		log.Error("Failed to list directory", "error", err)
+		return nil, err
+	}
+	if len(partitions) == 0 {
+		return nil, fmt.Errorf("no matching files found in directory: %s", directory.DirectoryPath)
+	}
+
+	errs := make([]error, len(partitions))
+	forEachPartition(partitions, options.parallelism(), func(i int, partition *Partition) {
+		partition.Table, errs[i] = ReadTable(partitionFilePath(directory, partition), partitionFileType(directory, partition))
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			log.Error("Failed to read directory file", "file", partitions[i].File, "error", err)
+			return nil, fmt.Errorf("failed to read %s: %v", partitions[i].File, err)
+		}
+	}
+
+	log.Debug("Directory read successfully", "path", directory.DirectoryPath, "files", len(partitions))
+	return partitions, nil
+}
+
+// ReadDirectory reads every matching file of a directory dataset as a single table. Columns
+// are the union of the files' columns, partition keys and the optional source column.
+func ReadDirectory(directory *pb.Directory, options DirectoryReadOptions) (*Table, error) {
+	partitions, err := ReadDirectoryPartitions(directory, options)
+	if err != nil {
+		return nil, err
+	}
+	return ConcatPartitions(partitions, options.SourceColumn), nil
+}
+
+// OpenDirectory opens a cursor that streams every matching file of a directory dataset as a
+// single table, in order while the following files are opened ahead, as many at a time as
+// Parallelism allows. The columns are those registered for the directory if all its files share
+// them, and are otherwise learned by opening every file before streaming.
+func OpenDirectory(directory *pb.Directory, dirOptions DirectoryReadOptions, options ReadOptions) (*Cursor, error) {
+	log := logger.GetLogger()
+	log.Debug("Opening directory", "path", directory.DirectoryPath, "pattern", dirOptions.Pattern, "partitioned", dirOptions.Partitioned)
+
+	partitions, err := listPartitions(directory, dirOptions)
+	if err != nil {
+		log.Error("Failed to list directory", "error", err)
+		return nil, err
+	}
+	if len(partitions) == 0 {
+		return nil, fmt.Errorf("no matching files found in directory: %s", directory.DirectoryPath)
+	}
+
+	parallelism := dirOptions.parallelism()
+	partitionColumns := [][]Column{registeredColumns(directory, dirOptions)}
+	if partitionColumns[0] == nil {
+		if partitionColumns, err = scanPartitionColumns(directory, partitions, parallelism); err != nil {
+			return nil, err
+		}
+	}
+
+	opened := make([]chan openedPartition, len(partitions))
+	for i := range opened {
+		opened[i] = make(chan openedPartition, 1)
+	}
+	return NewCursor(&directorySource{
+		directory:  directory,
+		partitions: partitions,
+		layout:     newDirectoryLayout(partitions, partitionColumns, dirOptions.SourceColumn),
+		current:    -1,
+		opened:     opened,
+		tokens:     make(chan struct{}, parallelism),
+		done:       make(chan struct{}),
+		prefetched: make(chan struct{}),
+	}, options)
+}
+
+// parallelism returns the number of files read concurrently
+func (o DirectoryReadOptions) parallelism() int {
+	if o.Parallelism <= 0 {
+		return runtime.NumCPU()
+	}
+	return o.Parallelism
+}
+
+// forEachPartition calls fn for every partition, running up to parallelism calls at once
+func forEachPartition(partitions []*Partition, parallelism int, fn func(i int, partition *Partition)) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, parallelism)
+	for i, partition := range partitions {
+		wg.Add(1)
+		go func(i int, partition *Partition) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			fn(i, partition)
+		}(i, partition)
+	}
+	wg.Wait()
+}
+
+// registeredColumns returns the columns registered for a directory's files, or nil if they can't
+// be relied on: if none were inferred, some files have another schema, or the read selects other
+// files than those inferred, by a pattern or from partition subdirectories
+func registeredColumns(directory *pb.Directory, options DirectoryReadOptions) []Column {
+	if options.Pattern != "" || options.Partitioned || len(directory.MismatchedFiles) > 0 || len(directory.ColumnNames) == 0 || len(directory.ColumnNames) != len(directory.ColumnTypes) {
+		return nil
+	}
+	columns := make([]Column, len(directory.ColumnNames))
+	for i, name := range directory.ColumnNames {
+		columns[i] = Column{Name: name, Type: directory.ColumnTypes[i]}
+	}
+	return columns
+}
+
+// scanPartitionColumns opens every file of a directory to learn its columns
+func scanPartitionColumns(directory *pb.Directory, partitions []*Partition, parallelism int) ([][]Column, error) {
+	partitionColumns := make([][]Column, len(partitions))
+	errs := make([]error, len(partitions))
+	forEachPartition(partitions, parallelism, func(i int, partition *Partition) {
+		source, err := OpenTable(partitionFilePath(directory, partition), partitionFileType(directory, partition))
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		partitionColumns[i] = source.Columns()
+		source.Close()
+	})
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", partitions[i].File, err)
+		}
+	}
+	return partitionColumns, nil
+}
+
+// ConcatPartitions concatenates partitions into a single table, widening column types where
+// files disagree and adding partition keys and an optional source column
+func ConcatPartitions(partitions []*Partition, sourceColumn string) *Table {
+	partitionColumns := make([][]Column, len(partitions))
+	for i, partition := range partitions {
+		partitionColumns[i] = partition.Table.Columns
+	}
+	layout := newDirectoryLayout(partitions, partitionColumns, sourceColumn)
+
+	table := &Table{Columns: layout.columns}
+	for _, partition := range partitions {
+		for _, row := range partition.Table.Rows {
+			table.Rows = append(table.Rows, layout.align(partition, partition.Table.Columns, row))
+		}
+	}
+	return table
+}
+
+// directoryLayout maps the columns of each file of a directory onto the union of all
+// files' columns, partition keys and the optional source column
+type directoryLayout struct {
+	columns      []Column
+	index        map[string]int
+	sourceColumn string
+}
+
+func newDirectoryLayout(partitions []*Partition, partitionColumns [][]Column, sourceColumn string) *directoryLayout {
+	layout := &directoryLayout{index: map[string]int{}, sourceColumn: sourceColumn}
+	for _, columns := range partitionColumns {
+		for _, column := range columns {
+			layout.addColumn(column.Name, column.Type)
+		}
+	}
+	for _, partition := range partitions {
+		for _, key := range sortedKeys(partition.Keys) {
+			layout.addColumn(key, inferStringType(partition.Keys[key]))
+		}
+	}
+	if sourceColumn != "" {
+		layout.addColumn(sourceColumn, TypeString)
+	}
+	finalizeColumnTypes(layout.columns)
+	return layout
+}
+
+func (l *directoryLayout) addColumn(name string, columnType string) {
+	if i, ok := l.index[name]; ok {
+		l.columns[i].Type = mergeType(l.columns[i].Type, columnType)
+		return
+	}
+	l.index[name] = len(l.columns)
+	l.columns = append(l.columns, Column{Name: name, Type: columnType})
+}
+
+// align converts a row read from a partition to a row of the union layout
+func (l *directoryLayout) align(partition *Partition, columns []Column, row []interface{}) []interface{} {
+	out := make([]interface{}, len(l.columns))
+	for i, column := range columns {
+		// Registered columns may not include every column of a file
+		if j, ok := l.index[column.Name]; ok {
+			out[j] = castValue(row[i], l.columns[j].Type)
+		}
+	}
+	for key, value := range partition.Keys {
+		j := l.index[key]
+		out[j] = convertString(value, l.columns[j].Type)
+	}
+	if l.sourceColumn != "" {
+		out[l.index[l.sourceColumn]] = partition.File
+	}
+	return out
+}
+
+// directorySource streams the files of a directory one after another, opening the following
+// files in the background. Each file opened holds a token until it is closed, so no more files
+// are open at once than there are tokens.
+type directorySource struct {
+	directory  *pb.Directory
+	partitions []*Partition
+	layout     *directoryLayout
+	current    int
+	source     RowSource
+
+	start      sync.Once
+	opened     []chan openedPartition // Files opened ahead, by partition
+	tokens     chan struct{}
+	done       chan struct{} // Closed when the source is closed, to stop opening files
+	prefetched chan struct{} // Closed once no more files will be opened
+	openers    sync.WaitGroup
+}
+
+// openedPartition is a file of a directory opened ahead of being streamed
+type openedPartition struct {
+	source RowSource
+	err    error
+}
+
+func (s *directorySource) Columns() []Column {
+	return s.layout.columns
+}
+
+func (s *directorySource) Next() ([]interface{}, error) {
+	s.start.Do(func() { go s.prefetch() })
+	for {
+		if s.source != nil {
+			row, err := s.source.Next()
+			if err == nil {
+				return s.layout.align(s.partitions[s.current], s.source.Columns(), row), nil
+			}
+			s.source.Close()
+			s.source = nil
+			<-s.tokens
+			if err != io.EOF {
+				return nil, err
+			}
+		}
+
+		s.current++
+		if s.current >= len(s.partitions) {
+			return nil, io.EOF
+		}
+		opened := <-s.opened[s.current]
+		if opened.err != nil {
+			<-s.tokens
+			return nil, fmt.Errorf("failed to read %s: %v", s.partitions[s.current].File, opened.err)
+		}
+		s.source = opened.source
+	}
+}
+
+// prefetch opens the files in order, as soon as a token is free
+func (s *directorySource) prefetch() {
+	defer close(s.prefetched)
+	for i, partition := range s.partitions {
+		select {
+		case s.tokens <- struct{}{}:
+		case <-s.done:
+			return
+		}
+		s.openers.Add(1)
+		go func(i int, partition *Partition) {
+			defer s.openers.Done()
+			source, err := OpenTable(partitionFilePath(s.directory, partition), partitionFileType(s.directory, partition))
+			s.opened[i] <- openedPartition{source: source, err: err}
+		}(i, partition)
+	}
+}
+
+// Close stops opening files and closes those opened but not streamed yet
+func (s *directorySource) Close() error {
+	select {
+	case <-s.done:
+		return nil
+	default:
+	}
+	s.start.Do(func() { close(s.prefetched) })
+	close(s.done)
+	<-s.prefetched
+	s.openers.Wait()
+
+	var err error
+	if s.source != nil {
+		err = s.source.Close()
+		s.source = nil
+	}
+	for i := s.current + 1; i < len(s.opened); i++ {
+		select {
+		case opened := <-s.opened[i]:
+			if opened.source != nil {
+				opened.source.Close()
+			}
+		default:
+		}
+	}
+	return err
+}
+
+func partitionFilePath(directory *pb.Directory, partition *Partition) string {
+	return filepath.Join(directory.DirectoryPath, filepath.FromSlash(partition.File))
+}
+
+func partitionFileType(directory *pb.Directory, partition *Partition) string {
+	if directory.FileType == "" {
+		return path.Ext(partition.File)
+	}
+	return directory.FileType
+}
+
+// listPartitions finds the files of a directory matching the read options
+func listPartitions(directory *pb.Directory, options DirectoryReadOptions) ([]*Partition, error) {
+	root := directory.DirectoryPath
+	matches := func(relPath string) (bool, error) {
+		if options.Pattern == "" {
+			return directory.FileType == "" || path.Ext(relPath) == directory.FileType, nil
+		}
+		if strings.Contains(options.Pattern, "/") {
+			return path.Match(options.Pattern, relPath)
+		}
+		return path.Match(options.Pattern, path.Base(relPath))
+	}
+
+	partitions := []*Partition{}
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		// Hidden files and directories aren't counted as the directory's files, so aren't read
+		if relPath != "." && isHiddenName(entry.Name()) {
+			return skipEntry(entry)
+		}
+		if entry.IsDir() {
+			if relPath != "." && !options.Partitioned {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		ok, err := matches(relPath)
+		if err != nil || !ok {
+			return err
+		}
+		partitions = append(partitions, &Partition{File: relPath, Keys: partitionKeys(relPath)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].File < partitions[j].File
+	})
+	return partitions, nil
+}
+
+// partitionKeys derives hive-style partition keys such as year=2024 from the
+// subdirectories of a relative file path
+func partitionKeys(relPath string) map[string]string {
+	keys := map[string]string{}
+	segments := strings.Split(path.Dir(relPath), "/")
+	for _, segment := range segments {
+		if key, value, ok := strings.Cut(segment, "="); ok && key != "" {
+			keys[key] = value
+		}
+	}
+	return keys
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func finalizeColumnTypes(columns []Column) {
+	for i := range columns {
+		if columns[i].Type == "" {
+			columns[i].Type = TypeString
+		}
+	}
+}
+
+// castValue converts a table value to the Go type used for a possibly wider column type
+func castValue(value interface{}, columnType string) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case int64:
+		if columnType == TypeFloat {
+			return float64(v)
+		}
+	case string:
+		return v
+	}
+	if columnType == TypeString {
+		return FormatValue(value)
+	}
+	return value
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pb "nexus/pkg/proto"
+)
+
+func TestPartitionKeys(t *testing.T) {
+	// Only directories named key=value are partitions, the innermost winning for repeated keys
+	keys := map[string]map[string]string{
+		"data.csv":                          {},
+		"year=2024/data.csv":                {"year": "2024"},
+		"year=2024/month=05/part-0.parquet": {"year": "2024", "month": "05"},
+		"raw/region=eu-west/data.csv":       {"region": "eu-west"},
+		"country=/data.csv":                 {"country": ""},
+		"=x/data.csv":                       {},
+		"expr=a=b/data.csv":                 {"expr": "a=b"},
+		"year=2023/year=2024/data.csv":      {"year": "2024"},
+		"dir/key=value.csv":                 {},
+	}
+	for relPath, want := range keys {
+		if got := partitionKeys(relPath); !reflect.DeepEqual(got, want) {
+			t.Errorf("partitionKeys(%q) = %v, want %v", relPath, got, want)
+		}
+	}
+}
+
+// writeDirectory creates a directory of CSV files, some in partition subdirectories and some
+// hidden
+func writeDirectory(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.csv":               "id,name\n1,x\n2,y\n",
+		"b.csv":               "id,name\n3,z\n",
+		"c.csv":               "id,name\n4,w\n5,v\n",
+		"region=eu/d.csv":     "id,score\n6,1.5\n",
+		"region=us/e.csv":     "id,name\n7,u\n",
+		".hidden/ignored.csv": "id\n0\n",
+	}
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestInferDirectory(t *testing.T) {
+	directory, err := CreateDirectory(writeDirectory(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := InferDirectory(directory); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(directory.ColumnNames, []string{"id", "name"}) {
+		t.Errorf("columns = %v, want id and name", directory.ColumnNames)
+	}
+	// Rows in partition subdirectories count, those of hidden files don't
+	if directory.RowCount != 7 {
+		t.Errorf("row count = %d, want 7", directory.RowCount)
+	}
+	if !reflect.DeepEqual(directory.MismatchedFiles, []string{"region=eu/d.csv"}) {
+		t.Errorf("mismatched files = %v, want the eu partition", directory.MismatchedFiles)
+	}
+}
+
+func TestOpenDirectory(t *testing.T) {
+	dir := writeDirectory(t)
+	directory, err := CreateDirectory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inferred, err := CreateDirectory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := InferDirectory(inferred); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		directory  *pb.Directory
+		dirOptions DirectoryReadOptions
+		options    ReadOptions
+		columns    []string
+		rows       [][]interface{}
+	}{
+		{
+			name:      "registered columns",
+			directory: inferred,
+			columns:   []string{"id", "name"},
+			rows:      [][]interface{}{{int64(1), "x"}, {int64(2), "y"}, {int64(3), "z"}, {int64(4), "w"}, {int64(5), "v"}},
+		},
+		{
+			name:       "scanned columns one file at a time",
+			directory:  directory,
+			dirOptions: DirectoryReadOptions{Parallelism: 1, SourceColumn: "file"},
+			options:    ReadOptions{Columns: []string{"id", "file"}},
+			columns:    []string{"id", "file"},
+			rows:       [][]interface{}{{int64(1), "a.csv"}, {int64(2), "a.csv"}, {int64(3), "b.csv"}, {int64(4), "c.csv"}, {int64(5), "c.csv"}},
+		},
+		{
+			name:       "partitions with their own columns",
+			directory:  inferred,
+			dirOptions: DirectoryReadOptions{Partitioned: true, Parallelism: 2},
+			options:    ReadOptions{Columns: []string{"id", "name", "score", "region"}, Offset: 4},
+			columns:    []string{"id", "name", "score", "region"},
+			rows:       [][]interface{}{{int64(5), "v", nil, nil}, {int64(6), nil, 1.5, "eu"}, {int64(7), "u", nil, "us"}},
+		},
+		{
+			name:       "limit stops before the files opened ahead",
+			directory:  directory,
+			dirOptions: DirectoryReadOptions{Pattern: "*.csv", Parallelism: 3},
+			options:    ReadOptions{Columns: []string{"id"}, Limit: 1},
+			columns:    []string{"id"},
+			rows:       [][]interface{}{{int64(1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := OpenDirectory(tt.directory, tt.dirOptions, tt.options)
+			if err != nil {
+				t.Fatalf("OpenDirectory: %v", err)
+			}
+			table, err := cursor.ReadAll()
+			if err != nil {
+				t.Fatalf("ReadAll: %v", err)
+			}
+			var columns []string
+			for _, column := range table.Columns {
+				columns = append(columns, column.Name)
+			}
+			if !reflect.DeepEqual(columns, tt.columns) {
+				t.Errorf("columns = %v, want %v", columns, tt.columns)
+			}
+			if !reflect.DeepEqual(table.Rows, tt.rows) {
+				t.Errorf("rows = %v, want %v", table.Rows, tt.rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Encodings of message values, set as EventStream.Encoding
+const (
+	EncodingUTF8     = "utf8"
+	EncodingJSON     = "json"
+	EncodingProtobuf = "protobuf" // Of the message type named by EventStream.Schema
+	EncodingAvro     = "avro"     // Binary, with the schema in EventStream.Schema
+	EncodingMsgpack  = "msgpack"
+)
+
+// Decoder decodes the message values of an event stream into generic data: nil, bool,
+// float64 or another number, json.Number, string, []byte, []interface{} or
+// map[string]interface{}
+type Decoder interface {
+	Decode(value []byte) (interface{}, error)
+}
+
+// DecoderFunc adapts a function to a Decoder
+type DecoderFunc func(value []byte) (interface{}, error)
+
+// Decode implements Decoder
+func (f DecoderFunc) Decode(value []byte) (interface{}, error) {
+	return f(value)
+}
+
+// DecoderFactory creates the decoder of an event stream, checking its schema
+type DecoderFactory func(es *pb.EventStream) (Decoder, error)
+
+// Record is a message with its value decoded according to its stream's encoding
+type Record struct {
+	*Message
+	Data interface{}
+}
+
+var (
+	encodingsMu sync.RWMutex
+	encodings   = map[string]DecoderFactory{}
+)
+
+// RegisterEncoding registers how values of an encoding are decoded, replacing any factory
+// previously registered for it
+func RegisterEncoding(name string, factory DecoderFactory) {
+	encodingsMu.Lock()
+	defer encodingsMu.Unlock()
+	encodings[strings.ToLower(name)] = factory
+}
+
+// NewDecoder creates the decoder for the encoding an event stream declares. Streams without an
+// encoding get a decoder returning values as strings, or as bytes if they aren't valid UTF-8.
+func NewDecoder(es *pb.EventStream) (Decoder, error) {
+	if es.Encoding == "" {
+		return DecoderFunc(decodeUndeclared), nil
+	}
+	encodingsMu.RLock()
+	factory, ok := encodings[strings.ToLower(es.Encoding)]
+	encodingsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown encoding: %s", es.Encoding)
+	}
+	return factory(es)
+}
+
+// DecodeMessage decodes the value of a message
+func DecodeMessage(decoder Decoder, message *Message) (*Record, error) {
+	data, err := decoder.Decode(message.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &Record{Message: message, Data: data}, nil
+}
+
+// Field returns the value at a dot separated path into the record's data, such as
+// "order.items.0.price", reporting whether it exists
+func (r *Record) Field(path string) (interface{}, bool) {
+	value := r.Data
+	if path == "" {
+		return value, true
+	}
+	for _, name := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[name]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(name)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// Fields returns the names of the top-level fields of the record's data, sorted, if it is an
+// object
+func (r *Record) Fields() []string {
+	object, ok := r.Data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FormatData formats decoded data on one line: strings as they are and anything else as JSON
+func FormatData(data interface{}) string {
+	if s, ok := data.(string); ok {
+		return s
+	}
+	text, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprint(data)
+	}
+	return string(text)
+}
+
+func init() {
+	RegisterEncoding(EncodingUTF8, func(es *pb.EventStream) (Decoder, error) {
+		return DecoderFunc(decodeUTF8), nil
+	})
+	RegisterEncoding(EncodingJSON, func(es *pb.EventStream) (Decoder, error) {
+		return DecoderFunc(decodeJSON), nil
+	})
+	RegisterEncoding(EncodingMsgpack, func(es *pb.EventStream) (Decoder, error) {
+		return DecoderFunc(decodeMsgpack), nil
+	})
+	RegisterEncoding(EncodingAvro, newAvroDecoder)
+	RegisterEncoding(EncodingProtobuf, newProtobufDecoder)
+}
+
+func decodeUndeclared(value []byte) (interface{}, error) {
+	if utf8.Valid(value) {
+		return string(value), nil
+	}
+	return value, nil
+}
+
+func decodeUTF8(value []byte) (interface{}, error) {
+	if !utf8.Valid(value) {
+		return nil, fmt.Errorf("value isn't valid UTF-8")
+	}
+	return string(value), nil
+}
+
+// decodeJSON keeps numbers as json.Number, so that large integers keep their precision
+func decodeJSON(value []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("invalid JSON value: %v", err)
+	}
+	return data, nil
+}
+
+// decodeMsgpack decodes maps with keys of any type, which genericData then keys by their text
+func decodeMsgpack(value []byte) (interface{}, error) {
+	decoder := msgpack.NewDecoder(bytes.NewReader(value))
+	decoder.SetMapDecoder(func(d *msgpack.Decoder) (interface{}, error) {
+		return d.DecodeUntypedMap()
+	})
+	data, err := decoder.DecodeInterface()
+	if err != nil {
+		return nil, fmt.Errorf("invalid msgpack value: %v", err)
+	}
+	return genericData(data), nil
+}
+
+// newAvroDecoder decodes binary Avro values with the stream's schema. Unions decode to an
+// object naming the branch taken, as in Avro's JSON encoding.
+func newAvroDecoder(es *pb.EventStream) (Decoder, error) {
+	if es.Schema == "" {
+		return nil, fmt.Errorf("avro encoding needs a schema")
+	}
+	codec, err := goavro.NewCodec(es.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Avro schema: %v", err)
+	}
+	return DecoderFunc(func(value []byte) (interface{}, error) {
+		data, _, err := codec.NativeFromBinary(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Avro value: %v", err)
+		}
+		return genericData(data), nil
+	}), nil
+}
+
+// newProtobufDecoder decodes protobuf values of the message type the stream names, defined by
+// the stream's descriptor set or compiled into the program. Values are converted as protojson
+// would, so 64-bit integers become strings and enums their names.
+func newProtobufDecoder(es *pb.EventStream) (Decoder, error) {
+	descriptor, err := protobufMessageType(es)
+	if err != nil {
+		return nil, err
+	}
+	return DecoderFunc(func(value []byte) (interface{}, error) {
+		message := dynamicpb.NewMessage(descriptor)
+		if err := proto.Unmarshal(value, message); err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", descriptor.FullName(), err)
+		}
+		text, err := protojson.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		return decodeJSON(text)
+	}), nil
+}
+
+// protobufMessageType resolves the descriptor of the message type an event stream names
+func protobufMessageType(es *pb.EventStream) (protoreflect.MessageDescriptor, error) {
+	if es.Schema == "" {
+		return nil, fmt.Errorf("protobuf encoding needs the full name of the message type as the schema")
+	}
+	files := protoregistry.GlobalFiles
+	if len(es.DescriptorSet) > 0 {
+		set := &descriptorpb.FileDescriptorSet{}
+		if err := proto.Unmarshal(es.DescriptorSet, set); err != nil {
+			return nil, fmt.Errorf("invalid descriptor set: %v", err)
+		}
+		var err error
+		if files, err = protodesc.NewFiles(set); err != nil {
+			return nil, fmt.Errorf("invalid descriptor set: %v", err)
+		}
+	}
+	found, err := files.FindDescriptorByName(protoreflect.FullName(es.Schema))
+	if err != nil {
+		return nil, fmt.Errorf("protobuf message type not found: %s", es.Schema)
+	}
+	descriptor, ok := found.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("not a protobuf message type: %s", es.Schema)
+	}
+	return descriptor, nil
+}
+
+// genericData converts maps with keys other than strings, which JSON can't represent, to maps
+// keyed by the keys' text
+func genericData(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = genericData(value)
+		}
+		return v
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			object[fmt.Sprint(key)] = genericData(value)
+		}
+		return object
+	case []interface{}:
+		for i, value := range v {
+			v[i] = genericData(value)
+		}
+		return v
+	default:
+		return data
+	}
+}
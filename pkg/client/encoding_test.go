@@ -0,0 +1,129 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecoders(t *testing.T) {
+	msgpackValue, err := msgpack.Marshal(map[interface{}]interface{}{"a": int8(1), 2: []interface{}{"x", true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	avroSchema := `{"type": "record", "name": "Order", "fields": [
+		{"name": "id", "type": "long"},
+		{"name": "note", "type": ["null", "string"]}
+	]}`
+	codec, err := goavro.NewCodec(avroSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	avroValue, err := codec.BinaryFromNative(nil, map[string]interface{}{"id": int64(7), "note": goavro.Union("string", "hi")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	protobufValue, err := proto.Marshal(&pb.Fingerprint{Hash: "abc", Size: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decodings := []struct {
+		name    string
+		es      *pb.EventStream
+		value   []byte
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "undeclared text", es: &pb.EventStream{}, value: []byte("héllo"), want: "héllo"},
+		{name: "undeclared binary", es: &pb.EventStream{}, value: []byte{0xff, 0x00}, want: []byte{0xff, 0x00}},
+		{name: "utf8", es: &pb.EventStream{Encoding: "utf8"}, value: []byte("text"), want: "text"},
+		{name: "invalid utf8", es: &pb.EventStream{Encoding: "utf8"}, value: []byte{0xff}, wantErr: true},
+		{
+			name:  "json keeps large integers",
+			es:    &pb.EventStream{Encoding: "JSON"},
+			value: []byte(`{"id": 12345678901234567890, "tags": ["a"]}`),
+			want:  map[string]interface{}{"id": json.Number("12345678901234567890"), "tags": []interface{}{"a"}},
+		},
+		{name: "invalid json", es: &pb.EventStream{Encoding: "json"}, value: []byte(`{"id":`), wantErr: true},
+		{
+			name:  "msgpack keys maps by their text",
+			es:    &pb.EventStream{Encoding: "msgpack"},
+			value: msgpackValue,
+			want:  map[string]interface{}{"a": int8(1), "2": []interface{}{"x", true}},
+		},
+		{name: "invalid msgpack", es: &pb.EventStream{Encoding: "msgpack"}, value: []byte{0xc1}, wantErr: true},
+		{
+			name:  "avro names union branches",
+			es:    &pb.EventStream{Encoding: "avro", Schema: avroSchema},
+			value: avroValue,
+			want:  map[string]interface{}{"id": int64(7), "note": map[string]interface{}{"string": "hi"}},
+		},
+		{name: "invalid avro", es: &pb.EventStream{Encoding: "avro", Schema: avroSchema}, value: []byte{0x0e, 0x04}, wantErr: true},
+		{
+			name:  "protobuf as protojson",
+			es:    &pb.EventStream{Encoding: "protobuf", Schema: "nexus.Fingerprint"},
+			value: protobufValue,
+			want:  map[string]interface{}{"hash": "abc", "size": "12"},
+		},
+		{name: "invalid protobuf", es: &pb.EventStream{Encoding: "protobuf", Schema: "nexus.Fingerprint"}, value: []byte{0x0a, 0x05}, wantErr: true},
+	}
+	for _, decoding := range decodings {
+		decoder, err := NewDecoder(decoding.es)
+		if err != nil {
+			t.Errorf("%s: %v", decoding.name, err)
+			continue
+		}
+		got, err := decoder.Decode(decoding.value)
+		if (err != nil) != decoding.wantErr {
+			t.Errorf("%s: decode error = %v, want error %v", decoding.name, err, decoding.wantErr)
+		} else if !decoding.wantErr && !reflect.DeepEqual(got, decoding.want) {
+			t.Errorf("%s: decoded %#v, want %#v", decoding.name, got, decoding.want)
+		}
+	}
+}
+
+func TestNewDecoderErrors(t *testing.T) {
+	invalid := map[string]*pb.EventStream{
+		"unknown encoding":        {Encoding: "xml"},
+		"avro without a schema":   {Encoding: "avro"},
+		"invalid avro schema":     {Encoding: "avro", Schema: `{"type": "nope"}`},
+		"protobuf without a type": {Encoding: "protobuf"},
+		"unknown protobuf type":   {Encoding: "protobuf", Schema: "nexus.Missing"},
+		"invalid descriptor set":  {Encoding: "protobuf", Schema: "x.Y", DescriptorSet: []byte{0xff}},
+	}
+	for name, es := range invalid {
+		if _, err := NewDecoder(es); err == nil {
+			t.Errorf("%s: decoder created", name)
+		}
+	}
+}
+
+func TestRecordField(t *testing.T) {
+	record := &Record{Data: map[string]interface{}{
+		"order": map[string]interface{}{"items": []interface{}{map[string]interface{}{"price": json.Number("9.5")}}},
+	}}
+	fields := []struct {
+		path string
+		want interface{}
+		ok   bool
+	}{
+		{"order.items.0.price", json.Number("9.5"), true},
+		{"order.items.1.price", nil, false},
+		{"order.items.x", nil, false},
+		{"order.missing", nil, false},
+		{"order.items.0.price.more", nil, false},
+	}
+	for _, field := range fields {
+		got, ok := record.Field(field.path)
+		if ok != field.ok || !reflect.DeepEqual(got, field.want) {
+			t.Errorf("Field(%q) = %v, %v, want %v, %v", field.path, got, ok, field.want, field.ok)
+		}
+	}
+}
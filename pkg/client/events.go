@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"io"
+	"nexus/pkg/logger"
+
+	pb "nexus/pkg/proto"
+)
+
+// Types of the events sent to subscribers
+const (
+	EventRegistered = "registered" // A value was registered or replaced at the path
+	EventChanged    = "changed"    // The contents of the file or directory at the path changed
+	EventDeleted    = "deleted"    // The path was deleted
+)
+
+// Subscribe streams the events of a path, and of the paths below it if recursive, until ctx
+// is done or the connection to the server is lost, after which the channel is closed
+func (n *NexusClient) Subscribe(ctx context.Context, path string, recursive bool) (<-chan *pb.Event, error) {
+	log := logger.GetLogger()
+	log.Debug("Subscribing", "path", path, "recursive", recursive)
+
+	stream, err := n.Client.Subscribe(ctx, &pb.SubscribeRequest{Path: path, Recursive: recursive})
+	if err != nil {
+		log.Error("Failed to subscribe", "error", err)
+		return nil, err
+	}
+
+	events := make(chan *pb.Event)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Error("Subscription ended", "path", path, "error", err)
+				}
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"nexus/pkg/logger"
+	"path/filepath"
+	"sort"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// Bounds of the window of messages exported from an event stream when none are given
+const (
+	DefaultExportMessages = 1000
+	DefaultExportWindow   = 10 * time.Second
+)
+
+// ExportOptions controls how a dataset is exported to a local file
+type ExportOptions struct {
+	Format    string               // File type of a registered writer, from the file extension if empty
+	Read      ReadOptions          // Projection, filters and limits applied to the rows exported
+	Directory DirectoryReadOptions // Only used by directory datasets
+
+	Stream      StreamOptions // Only used by event streams
+	MaxMessages int           // Messages read from an event stream, DefaultExportMessages if zero
+	Window      time.Duration // How long an event stream is read for, DefaultExportWindow if zero
+
+	RegisterAs string // Trie path to register the exported file under, not registered if empty
+	Infer      bool   // Infer the schema of the exported file before registering it
+}
+
+// Export writes a snapshot of the dataset registered at path to a local file and returns the
+// number of rows written. Event streams are read until the message or time bound of the
+// options is reached, whichever comes first. If RegisterAs is set, the file is registered as
+// an IndividualFile derived from path.
+func (n *NexusClient) Export(path string, filePath string, options ExportOptions) (int64, error) {
+	log := logger.GetLogger()
+	log.Info("Exporting dataset", "path", path, "file", filePath)
+
+	fileType := options.Format
+	if fileType == "" {
+		fileType = filepath.Ext(filePath)
+	}
+	if _, err := GetWriter(fileType); err != nil {
+		return 0, err
+	}
+
+	value, _, err := n.GetFull(path)
+	if err != nil {
+		return 0, err
+	}
+	var cursor *Cursor
+	switch v := value.(type) {
+	case *pb.EventStream:
+		table, err := readEventWindow(v, options.Stream, options.MaxMessages, options.Window)
+		if err != nil {
+			return 0, err
+		}
+		cursor, err = NewCursor(NewTableSource(table), options.Read)
+		if err != nil {
+			return 0, err
+		}
+	case *pb.Directory:
+		cursor, err = OpenDirectory(v, options.Directory, options.Read)
+	default:
+		cursor, err = n.OpenDataset(path, options.Read)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := WriteCursor(cursor, filePath, fileType)
+	if err != nil {
+		return count, err
+	}
+	log.Info("Dataset exported", "path", path, "file", filePath, "rows", count)
+
+	if options.RegisterAs != "" {
+		if err := n.registerExport(path, filePath, fileType, options); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// registerExport registers an exported file and declares the dataset it was exported from as
+// its upstream
+func (n *NexusClient) registerExport(path string, filePath string, fileType string, options ExportOptions) error {
+	log := logger.GetLogger()
+
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return err
+	}
+	file := CreateIndividualFile(absPath)
+	file.FileType = "." + normalizeFileType(fileType)
+	if options.Infer {
+		if err := InferIndividualFile(file); err != nil {
+			log.Warn("Failed to infer file schema", "path", absPath, "error", err)
+		}
+	}
+	if err := n.PublishIndividualFile(options.RegisterAs, file); err != nil {
+		return fmt.Errorf("failed to register exported file: %v", err)
+	}
+	if err := n.DeclareLineage(options.RegisterAs, path); err != nil {
+		return fmt.Errorf("failed to declare lineage of exported file: %v", err)
+	}
+	return nil
+}
+
+// readEventWindow reads messages from an event stream until maxMessages have arrived or the
+// window has passed, and returns them as a table. If every message is a JSON object, their
+// flattened fields become the columns, otherwise each message is a row of a single column.
+func readEventWindow(es *pb.EventStream, streamOptions StreamOptions, maxMessages int, window time.Duration) (*Table, error) {
+	log := logger.GetLogger()
+	if maxMessages <= 0 {
+		maxMessages = DefaultExportMessages
+	}
+	if window <= 0 {
+		window = DefaultExportWindow
+	}
+
+	// A snapshot mustn't take partitions away from the stream's default group
+	if streamOptions.GroupID == "" {
+		streamOptions.Standalone = true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), window)
+	defer cancel()
+	messageChan, err := GetEventStreamContext(ctx, es, streamOptions)
+	if err != nil {
+		return nil, err
+	}
+	var messages [][]byte
+	for message := range messageChan {
+		messages = append(messages, message)
+		if len(messages) >= maxMessages {
+			cancel()
+			break
+		}
+	}
+	log.Debug("Read event stream window", "topic", es.Topic, "messages", len(messages))
+	return messageTable(messages), nil
+}
+
+// messageTable converts event stream messages to a table
+func messageTable(messages [][]byte) *Table {
+	records := make([]map[string]interface{}, 0, len(messages))
+	for _, message := range messages {
+		var record map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(message))
+		decoder.UseNumber()
+		if err := decoder.Decode(&record); err != nil || record == nil {
+			records = nil
+			break
+		}
+		records = append(records, flattenRecord(record))
+	}
+
+	if records == nil {
+		table := &Table{Columns: []Column{{Name: "message", Type: TypeString}}}
+		for _, message := range messages {
+			table.Rows = append(table.Rows, []interface{}{string(message)})
+		}
+		return table
+	}
+
+	types := map[string]string{}
+	var names []string
+	for _, record := range records {
+		for key, value := range record {
+			if _, seen := types[key]; !seen {
+				names = append(names, key)
+			}
+			types[key] = mergeType(types[key], jsonValueType(value))
+		}
+	}
+	sort.Strings(names)
+	columnTypes := make([]string, len(names))
+	for i, name := range names {
+		columnTypes[i] = types[name]
+	}
+
+	table := &Table{Columns: stringColumns(names, columnTypes)}
+	for _, record := range records {
+		row := make([]interface{}, len(table.Columns))
+		for i, column := range table.Columns {
+			row[i] = jsonValue(record[column.Name], column.Type)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
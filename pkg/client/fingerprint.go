@@ -0,0 +1,135 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// FingerprintAlgorithm is the hash algorithm used for fingerprints
+const FingerprintAlgorithm = "sha256"
+
+// FingerprintFile computes the fingerprint of a file. If the file's size and modification time
+// still match a previous fingerprint, it is returned as is without reading the file.
+func FingerprintFile(filePath string, previous *pb.Fingerprint) (*pb.Fingerprint, error) {
+	log := logger.GetLogger()
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("not a file: %s", filePath)
+	}
+	if unchanged(previous, info.Size(), info.ModTime()) {
+		return previous, nil
+	}
+
+	log.Debug("Hashing file", "path", filePath, "size", info.Size())
+	hash := sha256.New()
+	if err := hashFile(hash, filePath); err != nil {
+		return nil, err
+	}
+	return newFingerprint(info.Size(), info.ModTime(), hash.Sum(nil)), nil
+}
+
+// FingerprintDirectory computes the fingerprint of the files in a directory and its
+// subdirectories, skipping hidden ones. The hash covers each file's relative path and
+// contents. If the total size and latest modification time still match a previous fingerprint,
+// it is returned as is without reading the files.
+func FingerprintDirectory(directoryPath string, previous *pb.Fingerprint) (*pb.Fingerprint, error) {
+	log := logger.GetLogger()
+
+	var files []string
+	var size int64
+	var modTime time.Time
+	err := filepath.WalkDir(directoryPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != directoryPath && isHiddenName(entry.Name()) {
+			return skipEntry(entry)
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		// Directories count too, as removing a file only changes the modification time of its directory
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+		if !entry.IsDir() {
+			files = append(files, filePath)
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unchanged(previous, size, modTime) {
+		return previous, nil
+	}
+
+	log.Debug("Hashing directory", "path", directoryPath, "files", len(files), "size", size)
+	hash := sha256.New()
+	for _, filePath := range files {
+		// WalkDir visits files in lexical order, so the hash doesn't depend on listing order
+		rel, err := filepath.Rel(directoryPath, filePath)
+		if err != nil {
+			return nil, err
+		}
+		fileHash := sha256.New()
+		if err := hashFile(fileHash, filePath); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(hash, "%s\x00", filepath.ToSlash(rel))
+		hash.Write(fileHash.Sum(nil))
+	}
+	return newFingerprint(size, modTime, hash.Sum(nil)), nil
+}
+
+// FingerprintValue computes the fingerprint of a file or directory node value, given its
+// previous fingerprint. It returns nil for other values.
+func FingerprintValue(value interface{}) (*pb.Fingerprint, error) {
+	switch v := value.(type) {
+	case *pb.IndividualFile:
+		return FingerprintFile(v.FilePath, v.Fingerprint)
+	case *pb.Directory:
+		return FingerprintDirectory(v.DirectoryPath, v.Fingerprint)
+	}
+	return nil, nil
+}
+
+func unchanged(previous *pb.Fingerprint, size int64, modTime time.Time) bool {
+	return previous != nil && previous.Algorithm == FingerprintAlgorithm &&
+		previous.Size == size && previous.ModTime == modTime.UnixMicro()
+}
+
+func newFingerprint(size int64, modTime time.Time, sum []byte) *pb.Fingerprint {
+	return &pb.Fingerprint{
+		Size:       size,
+		ModTime:    modTime.UnixMicro(),
+		Hash:       hex.EncodeToString(sum),
+		Algorithm:  FingerprintAlgorithm,
+		ComputedAt: time.Now().Unix(),
+	}
+}
+
+func hashFile(hash io.Writer, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(hash, file)
+	return err
+}
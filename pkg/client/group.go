@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"nexus/pkg/logger"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/IBM/sarama"
+)
+
+// Ways a consumer group commits the offsets of the messages it consumed
+const (
+	CommitAuto   = "auto"   // Offsets of delivered messages are committed periodically
+	CommitManual = "manual" // Offsets are only committed by Subscription.Commit
+)
+
+// groupConsumer consumes an event stream into a sink as a member of a Kafka consumer
+// group, which shares the topic's partitions between its members and resumes from the offsets
+// it committed
+type groupConsumer struct {
+	topic   string
+	group   sarama.ConsumerGroup
+	options StreamOptions
+	sink    MessageSink
+	done    chan struct{} // Closed once the consumer has left the group
+
+	mu        sync.Mutex
+	session   sarama.ConsumerGroupSession
+	delivered map[int32]int64 // Offset of the last message delivered from each partition
+}
+
+// groupID returns the consumer group an event stream is consumed by, if any. The stream's
+// default group isn't joined by consumers selecting their own partitions or start offsets.
+func groupID(es *pb.EventStream, options StreamOptions) string {
+	if options.GroupID != "" || options.Standalone || options.positioned() {
+		return options.GroupID
+	}
+	return es.DefaultGroupId
+}
+
+// JoinGroup subscribes to an event stream as a member of the consumer group named in the
+// options, or the stream's default group. Partitions without a committed offset start from the
+// newest message, or the oldest if the options start there. With CommitManual, offsets are
+// only committed by Subscription.Commit.
+func JoinGroup(ctx context.Context, es *pb.EventStream, options StreamOptions) (*Subscription, error) {
+	log := logger.GetLogger()
+	if es == nil {
+		log.Error("No event stream provided")
+		return nil, fmt.Errorf("no event stream provided")
+	}
+	if options.positioned() {
+		return nil, fmt.Errorf("consumer groups resume from committed offsets and can't select partitions or start offsets")
+	}
+	options.GroupID = groupID(es, options)
+	if options.GroupID == "" {
+		return nil, fmt.Errorf("no consumer group given and stream has no default group")
+	}
+	return SubscribeEventStream(ctx, es, options)
+}
+
+// joinKafkaGroup consumes a Kafka topic into sink as a member of a consumer group
+func joinKafkaGroup(ctx context.Context, es *pb.EventStream, group string, options StreamOptions, sink MessageSink) (Consumer, error) {
+	log := logger.GetLogger()
+	config, err := kafkaConfig(es)
+	if err != nil {
+		return nil, err
+	}
+	config.Consumer.Return.Errors = true
+	if options.CommitMode == CommitManual {
+		config.Consumer.Offsets.AutoCommit.Enable = false
+	} else if options.CommitInterval > 0 {
+		config.Consumer.Offsets.AutoCommit.Interval = options.CommitInterval
+	}
+	if options.Start == StartOldest {
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	}
+
+	log.Debug("Joining consumer group", "brokers", StreamServers(es), "topic", es.Topic, "group", group)
+	consumerGroup, err := sarama.NewConsumerGroup(StreamServers(es), group, config)
+	if err != nil {
+		log.Error("Failed to join consumer group", "group", group, "error", err)
+		return nil, fmt.Errorf("failed to join consumer group: %v", err)
+	}
+
+	consumer := &groupConsumer{
+		topic:     es.Topic,
+		group:     consumerGroup,
+		options:   options,
+		sink:      sink,
+		delivered: map[int32]int64{},
+		done:      make(chan struct{}),
+	}
+	go consumer.run(ctx, group)
+	return consumer, nil
+}
+
+// Done implements Consumer
+func (g *groupConsumer) Done() <-chan struct{} {
+	return g.done
+}
+
+// Commit commits the offsets of every message delivered so far, without waiting for the next
+// automatic commit in CommitAuto mode. Messages delivered but not committed when partitions are
+// reassigned are delivered again to their new owner.
+func (g *groupConsumer) Commit() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.session == nil {
+		return fmt.Errorf("no partitions assigned")
+	}
+	for partition, offset := range g.delivered {
+		// The committed offset is that of the next message to consume
+		g.session.MarkOffset(g.topic, partition, offset+1, "")
+	}
+	g.session.Commit()
+	return nil
+}
+
+// run consumes the topic until ctx is done, rejoining the group after each rebalance
+func (g *groupConsumer) run(ctx context.Context, group string) {
+	log := logger.GetLogger()
+	errorsDone := make(chan struct{})
+	defer func() {
+		// Closing the group commits marked offsets and closes its error channel
+		g.group.Close()
+		<-errorsDone
+		close(g.done)
+	}()
+
+	go func() {
+		defer close(errorsDone)
+		for err := range g.group.Errors() {
+			log.Error("Error consuming message", "group", group, "error", err)
+			g.sink.Error(err)
+		}
+	}()
+	for {
+		if err := g.group.Consume(ctx, []string{g.topic}, g); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+			log.Error("Consumer group session failed", "group", group, "error", err)
+			g.sink.Error(err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+		if ctx.Err() != nil {
+			log.Debug("Leaving consumer group", "group", group)
+			return
+		}
+	}
+}
+
+// Setup implements sarama.ConsumerGroupHandler
+func (g *groupConsumer) Setup(session sarama.ConsumerGroupSession) error {
+	g.mu.Lock()
+	g.session = session
+	g.delivered = map[int32]int64{}
+	g.mu.Unlock()
+
+	logger.GetLogger().Info("Consumer group partitions assigned", "member", session.MemberID(), "claims", session.Claims())
+	if g.options.OnAssign != nil {
+		g.options.OnAssign(session.Claims()[g.topic])
+	}
+	return nil
+}
+
+// Cleanup implements sarama.ConsumerGroupHandler
+func (g *groupConsumer) Cleanup(session sarama.ConsumerGroupSession) error {
+	logger.GetLogger().Info("Consumer group partitions revoked", "member", session.MemberID(), "claims", session.Claims())
+	if g.options.OnRevoke != nil {
+		g.options.OnRevoke(session.Claims()[g.topic])
+	}
+
+	g.mu.Lock()
+	g.session = nil
+	g.mu.Unlock()
+	return nil
+}
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler, delivering the messages of one partition
+// in order
+func (g *groupConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	log := logger.GetLogger()
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			log.Debug("Message received", "partition", msg.Partition, "offset", msg.Offset, "message", msg.Value)
+			manual := g.options.CommitMode == CommitManual
+			if manual {
+				// Recorded before delivery, so that a Commit right after receiving the message covers it
+				g.setDelivered(msg.Partition, msg.Offset)
+			}
+			if !g.sink.Deliver(session.Context(), kafkaMessage(msg)) {
+				if manual {
+					g.setDelivered(msg.Partition, msg.Offset-1)
+				}
+				return nil
+			}
+			if !manual {
+				session.MarkMessage(msg, "")
+			}
+		}
+	}
+}
+
+func (g *groupConsumer) setDelivered(partition int32, offset int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if offset < 0 {
+		delete(g.delivered, partition)
+		return
+	}
+	g.delivered[partition] = offset
+}
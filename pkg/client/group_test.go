@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// appendInProcess adds messages to the in-process topic of a stream
+func appendInProcess(es *pb.EventStream, values ...string) {
+	topic := getInProcessTopic(es)
+	for _, value := range values {
+		topic.append(&Message{Topic: es.Topic, Value: []byte(value), Timestamp: time.Now()})
+	}
+}
+
+func TestGroupID(t *testing.T) {
+	es := &pb.EventStream{DefaultGroupId: "dashboards"}
+	groups := []struct {
+		options StreamOptions
+		want    string
+	}{
+		{StreamOptions{}, "dashboards"},
+		{StreamOptions{Start: StartOldest}, "dashboards"},
+		{StreamOptions{GroupID: "billing"}, "billing"},
+		{StreamOptions{Standalone: true}, ""},
+		// Consumers choosing where to start don't take partitions from the default group
+		{StreamOptions{LastN: 5}, ""},
+		{StreamOptions{Partitions: []int32{0}}, ""},
+	}
+	for _, group := range groups {
+		if got := groupID(es, group.options); got != group.want {
+			t.Errorf("groupID with %+v = %q, want %q", group.options, got, group.want)
+		}
+	}
+	if got := groupID(&pb.EventStream{}, StreamOptions{}); got != "" {
+		t.Errorf("stream without a default group is consumed by group %q", got)
+	}
+}
+
+func TestJoinGroupErrors(t *testing.T) {
+	es := &pb.EventStream{Transport: TransportInProcess, Server: t.Name(), Topic: "orders"}
+	if _, err := JoinGroup(context.Background(), es, StreamOptions{}); err == nil {
+		t.Error("joined a group without naming one or a default")
+	}
+	if _, err := JoinGroup(context.Background(), es, StreamOptions{GroupID: "billing", LastN: 1}); err == nil {
+		t.Error("joined a group at a chosen start offset")
+	}
+	if _, err := JoinGroup(context.Background(), es, StreamOptions{GroupID: "billing", CommitMode: CommitManual}); err == nil {
+		t.Error("in-process group accepted manual commits")
+	}
+}
+
+// TestGroupResumes checks that members of a group share its position, so a member joining
+// later resumes where the previous one stopped
+func TestGroupResumes(t *testing.T) {
+	es := &pb.EventStream{Transport: TransportInProcess, Server: t.Name(), Topic: "orders", DefaultGroupId: "billing"}
+	appendInProcess(es, "a", "b", "c")
+
+	first, err := SubscribeEventStream(context.Background(), es, StreamOptions{Start: StartOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, first, 2); string(got[0].Value) != "a" || string(got[1].Value) != "b" {
+		t.Fatalf("first member received %s and %s", got[0].Value, got[1].Value)
+	}
+	first.Close()
+
+	second, err := JoinGroup(context.Background(), es, StreamOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	// The first member may have taken c before it closed
+	appendInProcess(es, "d")
+	got := receive(t, second, 1)
+	if value := string(got[0].Value); value != "c" && value != "d" {
+		t.Errorf("second member started at %s, want where the first stopped", value)
+	}
+
+	// A consumer outside the group still reads everything
+	standalone, err := SubscribeEventStream(context.Background(), es, StreamOptions{Standalone: true, Start: StartOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer standalone.Close()
+	if got := receive(t, standalone, 1); string(got[0].Value) != "a" {
+		t.Errorf("standalone consumer started at %s, want the oldest message", got[0].Value)
+	}
+}
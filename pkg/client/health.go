@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// Health statuses reported for registered resources
+const (
+	HealthUnknown   = "unknown"   // Not checked yet
+	HealthHealthy   = "healthy"   // Reachable and matching its registration
+	HealthDegraded  = "degraded"  // Reachable, but its registered metadata is out of date
+	HealthUnhealthy = "unhealthy" // Missing or unreachable
+	HealthUnchecked = "unchecked" // Not checked, as the server may not open it or has no credential scoped to it
+)
+
+// CheckHealth probes the resource behind a node value, giving up when ctx is done. It returns
+// nil for values with no external resource to check, such as stored values and queries. As it
+// runs on behalf of whoever registered the resource, database tables are only connected to
+// with their scoped credential.
+func CheckHealth(ctx context.Context, value interface{}) *pb.HealthStatus {
+	log := logger.GetLogger()
+
+	var status string
+	var err error
+	switch v := value.(type) {
+	case *pb.IndividualFile:
+		status, err = checkFile(v)
+	case *pb.Directory:
+		status, err = checkDirectory(v)
+	case *pb.DatabaseTable:
+		status, err = checkDatabaseTable(ctx, v)
+	case *pb.EventStream:
+		status, err = checkEventStream(ctx, v)
+	default:
+		return nil
+	}
+
+	health := &pb.HealthStatus{Status: status, LastChecked: time.Now().Unix()}
+	if err != nil {
+		log.Debug("Health check found a problem", "status", status, "error", err)
+		health.LastError = err.Error()
+	}
+	return health
+}
+
+// checkFile checks that a registered file still exists
+func checkFile(file *pb.IndividualFile) (string, error) {
+	info, err := os.Stat(file.FilePath)
+	if err != nil {
+		return HealthUnhealthy, err
+	}
+	if info.IsDir() {
+		return HealthUnhealthy, fmt.Errorf("not a file: %s", file.FilePath)
+	}
+	return HealthHealthy, nil
+}
+
+// checkDirectory checks that a registered directory still exists and holds the number of
+// files it was registered with, counting files the same way as CreateDirectory
+func checkDirectory(directory *pb.Directory) (string, error) {
+	fileCount := 0
+	err := filepath.WalkDir(directory.DirectoryPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != directory.DirectoryPath && isHiddenName(entry.Name()) {
+			return skipEntry(entry)
+		}
+		if !entry.IsDir() {
+			fileCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return HealthUnhealthy, err
+	}
+	if fileCount == 0 {
+		return HealthUnhealthy, fmt.Errorf("no files found in directory: %s", directory.DirectoryPath)
+	}
+	if fileCount != int(directory.FileCount) {
+		return HealthDegraded, fmt.Errorf("file_count is stale: registered %d files, found %d", directory.FileCount, fileCount)
+	}
+	return HealthHealthy, nil
+}
+
+// checkDatabaseTable checks that a table's database is reachable and the table can be queried,
+// leaving tables without a scoped credential unchecked rather than using default credentials
+func checkDatabaseTable(ctx context.Context, table *pb.DatabaseTable) (string, error) {
+	err := verifyDatabaseTable(ctx, table, ScopedCredential)
+	if errors.Is(err, ErrNoScopedCredential) {
+		return HealthUnchecked, err
+	}
+	if err != nil {
+		return HealthUnhealthy, err
+	}
+	return HealthHealthy, nil
+}
+
+// checkEventStream checks that a stream's servers are reachable through the driver of its
+// transport, and for Kafka that its topic exists
+func checkEventStream(ctx context.Context, es *pb.EventStream) (string, error) {
+	driver, err := GetTransport(es.Transport)
+	if err != nil {
+		return HealthUnhealthy, err
+	}
+	if err := driver.Check(ctx, es); err != nil {
+		return HealthUnhealthy, err
+	}
+	return HealthHealthy, nil
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// inProcessRetention is the number of messages each in-process topic retains
+const inProcessRetention = 10000
+
+var (
+	inProcessMu     sync.Mutex
+	inProcessTopics = map[string]*inProcessTopic{} // Topics, by server name and topic
+)
+
+// inProcessTopic is a single partition of messages held in memory
+type inProcessTopic struct {
+	mu       sync.Mutex
+	base     int64            // Offset of the oldest message retained
+	messages []*Message       // Messages retained, oldest first
+	notify   chan struct{}    // Closed and replaced when a message is appended
+	groups   map[string]int64 // Offset of the next message for each consumer group
+}
+
+// inProcessDriver carries event streams over topics held in the memory of the current process,
+// for tests and local development. Streams naming the same server, "default" if none, and
+// topic share messages. Topics have a single partition and retain their latest messages.
+type inProcessDriver struct{}
+
+// getInProcessTopic returns the in-process topic of an event stream, creating it if needed
+func getInProcessTopic(es *pb.EventStream) *inProcessTopic {
+	server := "default"
+	if servers := StreamServers(es); len(servers) > 0 {
+		server = servers[0]
+	}
+	inProcessMu.Lock()
+	defer inProcessMu.Unlock()
+	key := server + "/" + es.Topic
+	topic, ok := inProcessTopics[key]
+	if !ok {
+		topic = &inProcessTopic{notify: make(chan struct{}), groups: map[string]int64{}}
+		inProcessTopics[key] = topic
+	}
+	return topic
+}
+
+// Subscribe implements TransportDriver. Consumer group members share the group's position,
+// which advances as messages are delivered.
+func (inProcessDriver) Subscribe(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error) {
+	if err := onlyPartitionZero(TransportInProcess, options); err != nil {
+		return nil, err
+	}
+	group := groupID(es, options)
+	if group != "" {
+		if err := noManualCommit(TransportInProcess, options); err != nil {
+			return nil, err
+		}
+	}
+
+	topic := getInProcessTopic(es)
+	topic.mu.Lock()
+	next := topic.startOffset(options)
+	if _, ok := topic.groups[group]; group != "" && !ok {
+		topic.groups[group] = next
+	}
+	topic.mu.Unlock()
+
+	done := make(doneConsumer)
+	go func() {
+		defer close(done)
+		for {
+			message, notify := topic.next(group, &next)
+			if message == nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-notify:
+				}
+				continue
+			}
+			if !sink.Deliver(ctx, message) {
+				return
+			}
+		}
+	}()
+	return done, nil
+}
+
+// NewProducer implements TransportDriver
+func (inProcessDriver) NewProducer(es *pb.EventStream, options ProducerOptions) (TransportProducer, error) {
+	return inProcessProducer{}, nil
+}
+
+// Check implements TransportDriver, in-process topics are always available
+func (inProcessDriver) Check(ctx context.Context, es *pb.EventStream) error {
+	return nil
+}
+
+// startOffset resolves the offset a subscription starts at, the caller must hold t.mu
+func (t *inProcessTopic) startOffset(options StreamOptions) int64 {
+	end := t.base + int64(len(t.messages))
+	if offset, ok := options.Offsets[0]; ok {
+		return max(t.base, min(offset, end))
+	}
+	switch {
+	case !options.Since.IsZero():
+		for i, message := range t.messages {
+			if !message.Timestamp.Before(options.Since) {
+				return t.base + int64(i)
+			}
+		}
+		return end
+	case options.LastN > 0:
+		return max(t.base, end-options.LastN)
+	case options.Start == StartOldest:
+		return t.base
+	default:
+		return end
+	}
+}
+
+// next returns a copy of the next message for a consumer, advancing its group's position if
+// it has a group, or else the channel notifying of the next message appended
+func (t *inProcessTopic) next(group string, next *int64) (*Message, <-chan struct{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	offset := *next
+	if group != "" {
+		offset = t.groups[group]
+	}
+	// Messages dropped from the topic before they were read are skipped
+	offset = max(offset, t.base)
+	if offset >= t.base+int64(len(t.messages)) {
+		return nil, t.notify
+	}
+	message := *t.messages[offset-t.base]
+	if group != "" {
+		t.groups[group] = offset + 1
+	}
+	*next = offset + 1
+	return &message, nil
+}
+
+// append adds a message to the topic and returns its offset
+func (t *inProcessTopic) append(message *Message) int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	message.Offset = t.base + int64(len(t.messages))
+	t.messages = append(t.messages, message)
+	if len(t.messages) > inProcessRetention {
+		t.messages = t.messages[1:]
+		t.base++
+	}
+	close(t.notify)
+	t.notify = make(chan struct{})
+	return message.Offset
+}
+
+// inProcessProducer appends messages to in-process topics, delivering them immediately
+type inProcessProducer struct{}
+
+// Send implements TransportProducer
+func (inProcessProducer) Send(es *pb.EventStream, message *Message, report func(Delivery)) error {
+	stored := &Message{
+		Topic:     es.Topic,
+		Key:       message.Key,
+		Value:     message.Value,
+		Headers:   message.Headers,
+		Timestamp: time.Now(),
+	}
+	offset := getInProcessTopic(es).append(stored)
+	report(Delivery{Topic: es.Topic, Offset: offset, Key: message.Key, Value: message.Value})
+	return nil
+}
+
+// Close implements TransportProducer
+func (inProcessProducer) Close() error {
+	return nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// newInProcessStream returns a stream on an empty in-process topic, dropped when the test ends
+func newInProcessStream(t *testing.T) *pb.EventStream {
+	es := &pb.EventStream{Transport: TransportInProcess, Server: t.Name(), Topic: "orders"}
+	drop := func() {
+		inProcessMu.Lock()
+		defer inProcessMu.Unlock()
+		delete(inProcessTopics, es.Server+"/"+es.Topic)
+	}
+	drop()
+	t.Cleanup(drop)
+	return es
+}
+
+func TestInProcessStartOffset(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	topic := getInProcessTopic(newInProcessStream(t))
+	for i := 0; i < 5; i++ {
+		topic.append(&Message{Value: []byte(fmt.Sprint(i)), Timestamp: start.Add(time.Duration(i) * time.Minute)})
+	}
+
+	offsets := []struct {
+		options StreamOptions
+		want    int64
+	}{
+		{StreamOptions{}, 5},
+		{StreamOptions{Start: StartOldest}, 0},
+		{StreamOptions{LastN: 2}, 3},
+		{StreamOptions{LastN: 10}, 0},
+		{StreamOptions{Since: start.Add(90 * time.Second)}, 2},
+		{StreamOptions{Since: start.Add(time.Hour)}, 5},
+		{StreamOptions{Offsets: map[int32]int64{0: 1}}, 1},
+		{StreamOptions{Offsets: map[int32]int64{0: 99}}, 5},
+	}
+	for _, offset := range offsets {
+		topic.mu.Lock()
+		got := topic.startOffset(offset.options)
+		topic.mu.Unlock()
+		if got != offset.want {
+			t.Errorf("start offset with %+v = %d, want %d", offset.options, got, offset.want)
+		}
+	}
+}
+
+// TestInProcessRetention checks that the oldest messages are dropped once a topic is full, and
+// that consumers asking for them start at the oldest one retained
+func TestInProcessRetention(t *testing.T) {
+	es := newInProcessStream(t)
+	topic := getInProcessTopic(es)
+	for i := 0; i < inProcessRetention+3; i++ {
+		topic.append(&Message{Value: []byte(fmt.Sprint(i))})
+	}
+	if topic.base != 3 || len(topic.messages) != inProcessRetention {
+		t.Fatalf("topic holds %d messages from offset %d, want %d from 3", len(topic.messages), topic.base, inProcessRetention)
+	}
+
+	subscription, err := SubscribeEventStream(context.Background(), es, StreamOptions{Offsets: map[int32]int64{0: 0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Close()
+	if message := receive(t, subscription, 1)[0]; message.Offset != 3 || string(message.Value) != "3" {
+		t.Errorf("first message %d: %s, want the oldest retained", message.Offset, message.Value)
+	}
+}
+
+func TestInProcessTransport(t *testing.T) {
+	if _, err := GetTransport("INPROC"); err != nil {
+		t.Errorf("transport names aren't case sensitive: %v", err)
+	}
+	if driver, err := GetTransport(""); err != nil || driver != (kafkaDriver{}) {
+		t.Errorf("streams without a transport use %v, %v, want Kafka", driver, err)
+	}
+	if _, err := GetTransport("carrier-pigeon"); err == nil {
+		t.Error("unknown transport found")
+	}
+
+	es := newInProcessStream(t)
+	rejected := []StreamOptions{
+		{Partitions: []int32{1}},
+		{Offsets: map[int32]int64{2: 0}},
+	}
+	for _, options := range rejected {
+		if _, err := SubscribeEventStream(context.Background(), es, options); err == nil {
+			t.Errorf("subscribed with %+v to a single partition topic", options)
+		}
+	}
+}
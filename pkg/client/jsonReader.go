@@ -0,0 +1,309 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// JSONReader reads a JSON array of objects, an object holding one such array, a single
+// object, or newline-delimited objects. Nested objects are flattened into dotted column
+// names and arrays are kept as JSON-encoded strings. Arrays and newline-delimited objects
+// are streamed, with columns collected in a first pass over the file.
+type JSONReader struct{}
+
+func init() {
+	RegisterReader("json", &JSONReader{})
+	RegisterReader("ndjson", &JSONReader{})
+	RegisterReader("jsonl", &JSONReader{})
+}
+
+// Open implements DatasetReader
+func (r *JSONReader) Open(filePath string) (RowSource, error) {
+	records, err := openJSONRecords(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer records.Close()
+
+	columns := []string{}
+	types := map[string]string{}
+	count := 0
+	for {
+		flat, err := records.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(flat))
+		for key := range flat {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if _, seen := types[key]; !seen {
+				columns = append(columns, key)
+			}
+			types[key] = mergeType(types[key], jsonValueType(flat[key]))
+		}
+		count++
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("no JSON records found")
+	}
+
+	columnTypes := make([]string, len(columns))
+	for i, column := range columns {
+		columnTypes[i] = types[column]
+	}
+
+	source := &jsonSource{columns: stringColumns(columns, columnTypes)}
+	if source.records, err = openJSONRecords(filePath); err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+// jsonRecords decodes the records of a JSON file one at a time, flattened into dotted keys
+type jsonRecords struct {
+	file      *os.File
+	decoder   *json.Decoder
+	inArray   bool
+	pending   []map[string]interface{} // Records decoded ahead of the stream, returned first
+	first     map[string]interface{}   // First object of a file that may hold a single document
+	documents int
+}
+
+func openJSONRecords(filePath string) (*jsonRecords, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(file)
+	records := &jsonRecords{file: file, decoder: json.NewDecoder(reader)}
+	records.decoder.UseNumber()
+
+	// Peek at the first non-space byte to tell a top-level array from objects
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		if b == ' ' || b == '\t' || b == '\n' || b == '\r' {
+			continue
+		}
+		reader.UnreadByte()
+		if b == '[' {
+			if _, err := records.decoder.Token(); err != nil {
+				file.Close()
+				return nil, err
+			}
+			records.inArray = true
+		}
+		return records, nil
+	}
+}
+
+func (r *jsonRecords) next() (map[string]interface{}, error) {
+	for {
+		if len(r.pending) > 0 {
+			record := r.pending[0]
+			r.pending = r.pending[1:]
+			return flattenRecord(record), nil
+		}
+
+		if r.inArray {
+			if !r.decoder.More() {
+				return nil, io.EOF
+			}
+			var element interface{}
+			if err := r.decoder.Decode(&element); err != nil {
+				return nil, err
+			}
+			if object, ok := element.(map[string]interface{}); ok {
+				return flattenRecord(object), nil
+			}
+			continue
+		}
+
+		var document interface{}
+		err := r.decoder.Decode(&document)
+		if err == io.EOF && r.first != nil {
+			// The file held a single object, which may itself hold the records
+			r.pending, err = jsonDocumentRecords(r.first)
+			r.first = nil
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		object, ok := document.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		r.documents++
+		if r.documents == 1 {
+			r.first = object
+			continue
+		}
+		if r.first != nil {
+			// More than one document, so the file holds newline-delimited records
+			r.pending = append(r.pending, r.first, object)
+			r.first = nil
+			continue
+		}
+		return flattenRecord(object), nil
+	}
+}
+
+func (r *jsonRecords) Close() error {
+	return r.file.Close()
+}
+
+// jsonSource streams the records of a JSON file as rows
+type jsonSource struct {
+	records *jsonRecords
+	columns []Column
+}
+
+func (s *jsonSource) Columns() []Column {
+	return s.columns
+}
+
+func (s *jsonSource) Next() ([]interface{}, error) {
+	flat, err := s.records.next()
+	if err != nil {
+		return nil, err
+	}
+	row := make([]interface{}, len(s.columns))
+	for i, column := range s.columns {
+		row[i] = jsonValue(flat[column.Name], column.Type)
+	}
+	return row, nil
+}
+
+func (s *jsonSource) Close() error {
+	return s.records.Close()
+}
+
+func flattenRecord(record map[string]interface{}) map[string]interface{} {
+	flat := map[string]interface{}{}
+	flattenJSON("", record, flat)
+	return flat
+}
+
+// jsonDocumentRecords extracts the records of a single top-level JSON object. An object with
+// no scalar fields that holds one array of objects yields the records of that array, otherwise
+// the object itself is the only record. Objects holding several arrays of objects are rejected,
+// as nothing tells which of them holds the records.
+func jsonDocumentRecords(document map[string]interface{}) ([]map[string]interface{}, error) {
+	var keys []string
+	var records []map[string]interface{}
+	for key, value := range document {
+		switch v := value.(type) {
+		case map[string]interface{}:
+		case []interface{}:
+			if objects := jsonObjects(v); len(objects) > 0 {
+				keys = append(keys, key)
+				records = objects
+			}
+		default:
+			return []map[string]interface{}{document}, nil
+		}
+	}
+	if len(keys) > 1 {
+		sort.Strings(keys)
+		return nil, fmt.Errorf("JSON object holds several arrays of records: %s", strings.Join(keys, ", "))
+	}
+	if records == nil {
+		return []map[string]interface{}{document}, nil
+	}
+	return records, nil
+}
+
+func jsonObjects(array []interface{}) []map[string]interface{} {
+	objects := []map[string]interface{}{}
+	for _, element := range array {
+		if object, ok := element.(map[string]interface{}); ok {
+			objects = append(objects, object)
+		}
+	}
+	return objects
+}
+
+func flattenJSON(prefix string, object map[string]interface{}, out map[string]interface{}) {
+	for key, value := range object {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenJSON(key, nested, out)
+		} else {
+			out[key] = value
+		}
+	}
+}
+
+func jsonValueType(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case bool:
+		return TypeBool
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return TypeInt
+		}
+		return TypeFloat
+	default:
+		return TypeString
+	}
+}
+
+// jsonValue converts a decoded JSON value to the Go type used for the column type
+func jsonValue(value interface{}, columnType string) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case json.Number:
+		switch columnType {
+		case TypeInt:
+			if i, err := v.Int64(); err == nil {
+				return i
+			}
+		case TypeFloat:
+			if f, err := v.Float64(); err == nil {
+				return f
+			}
+		}
+		return v.String()
+	case bool:
+		if columnType == TypeBool {
+			return v
+		}
+		return fmt.Sprintf("%t", v)
+	case string:
+		return v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	}
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// readJSONRecords writes content to a JSON file and returns its flattened records
+func readJSONRecords(t *testing.T, content string) ([]map[string]interface{}, error) {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "records.json")
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	records, err := openJSONRecords(file)
+	if err != nil {
+		return nil, err
+	}
+	defer records.Close()
+
+	var all []map[string]interface{}
+	for {
+		record, err := records.next()
+		if err == io.EOF {
+			return all, nil
+		}
+		if err != nil {
+			return all, err
+		}
+		all = append(all, record)
+	}
+}
+
+func TestJSONRecords(t *testing.T) {
+	layouts := map[string][]map[string]interface{}{
+		// Elements that aren't objects are skipped
+		`[{"a": 1}, 2, {"a": 3, "b": {"c": "x"}}]`:                {{"a": json.Number("1")}, {"a": json.Number("3"), "b.c": "x"}},
+		"{\"a\": 1}\n{\"a\": 2}\n\n{\"a\": 3}\n":                  {{"a": json.Number("1")}, {"a": json.Number("2")}, {"a": json.Number("3")}},
+		`{"a": 1, "b": [1, 2]}`:                                   {{"a": json.Number("1"), "b": []interface{}{json.Number("1"), json.Number("2")}}},
+		`{"meta": {"count": 2}, "users": [{"id": 1}, {"id": 2}]}`: {{"id": json.Number("1")}, {"id": json.Number("2")}},
+		"\n\t [{\"a\": true}]":                                    {{"a": true}},
+		"":                                                        nil,
+	}
+	for content, want := range layouts {
+		got, err := readJSONRecords(t, content)
+		if err != nil {
+			t.Errorf("reading %q: %v", content, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("records of %q = %v, want %v", content, got, want)
+		}
+	}
+}
+
+// TestJSONRecordsAmbiguous checks that an object holding several arrays of records is refused
+// rather than read as whichever array sorts first
+func TestJSONRecordsAmbiguous(t *testing.T) {
+	_, err := readJSONRecords(t, `{"users": [{"id": 1}], "products": [{"id": 101}], "tags": ["a"]}`)
+	if err == nil || !strings.Contains(err.Error(), "products, users") {
+		t.Fatalf("reading several arrays of records returned %v, want an error naming them", err)
+	}
+
+	if _, err := OpenTable("../../tests/example_b.json", ".json"); err == nil {
+		t.Error("example_b.json, holding users and products, was read as one of them")
+	}
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+)
+
+// JSONWriter writes a JSON array holding an object per row, keyed by column name, or
+// newline-delimited objects if NewlineDelimited is set
+type JSONWriter struct {
+	NewlineDelimited bool
+}
+
+func init() {
+	RegisterWriter("json", &JSONWriter{})
+	RegisterWriter("ndjson", &JSONWriter{NewlineDelimited: true})
+	RegisterWriter("jsonl", &JSONWriter{NewlineDelimited: true})
+}
+
+// Create implements DatasetWriter
+func (w *JSONWriter) Create(filePath string, columns []Column) (RowSink, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+	sink := &jsonSink{file: file, buffer: bufio.NewWriter(file), columns: columns, newlineDelimited: w.NewlineDelimited}
+	if !w.NewlineDelimited {
+		sink.buffer.WriteString("[")
+	}
+	return sink, nil
+}
+
+// jsonSink writes rows as JSON objects, keeping the column order of the dataset
+type jsonSink struct {
+	file             *os.File
+	buffer           *bufio.Writer
+	columns          []Column
+	newlineDelimited bool
+	count            int
+}
+
+func (s *jsonSink) Write(row []interface{}) error {
+	if !s.newlineDelimited {
+		if s.count > 0 {
+			s.buffer.WriteString(",")
+		}
+		s.buffer.WriteString("\n  ")
+	}
+	s.buffer.WriteString("{")
+	for i, column := range s.columns {
+		var value interface{}
+		if i < len(row) {
+			value = row[i]
+		}
+		key, err := json.Marshal(column.Name)
+		if err != nil {
+			return err
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			s.buffer.WriteString(", ")
+		}
+		s.buffer.Write(key)
+		s.buffer.WriteString(": ")
+		s.buffer.Write(encoded)
+	}
+	s.buffer.WriteString("}")
+	if s.newlineDelimited {
+		s.buffer.WriteString("\n")
+	}
+	s.count++
+	return nil
+}
+
+func (s *jsonSink) Close() error {
+	if !s.newlineDelimited {
+		if s.count > 0 {
+			s.buffer.WriteString("\n")
+		}
+		s.buffer.WriteString("]\n")
+	}
+	if err := s.buffer.Flush(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,300 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"nexus/pkg/logger"
+	"strings"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// KafkaBrokersEnv names the environment variable holding the comma separated brokers of event
+// streams created without any
+const KafkaBrokersEnv = "NEXUS_KAFKA_BROKERS"
+
+// Codecs messages can be produced with
+var kafkaCompressions = map[string]sarama.CompressionCodec{
+	"":       sarama.CompressionNone,
+	"none":   sarama.CompressionNone,
+	"gzip":   sarama.CompressionGZIP,
+	"snappy": sarama.CompressionSnappy,
+	"lz4":    sarama.CompressionLZ4,
+	"zstd":   sarama.CompressionZSTD,
+}
+
+// ParseBrokers splits a comma separated list of broker addresses
+func ParseBrokers(value string) []string {
+	var brokers []string
+	for _, broker := range strings.Split(value, ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	return brokers
+}
+
+// kafkaConfig builds the configuration connecting to the Kafka cluster of an event stream.
+// Credentials for SASL are resolved from the stream's credential reference.
+func kafkaConfig(es *pb.EventStream) (*sarama.Config, error) {
+	log := logger.GetLogger()
+	if len(StreamServers(es)) == 0 {
+		return nil, fmt.Errorf("event stream has no Kafka brokers")
+	}
+
+	config := sarama.NewConfig()
+	if es.ClientId != "" {
+		config.ClientID = es.ClientId
+	}
+	if es.KafkaVersion != "" {
+		version, err := sarama.ParseKafkaVersion(es.KafkaVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Kafka version %q: %v", es.KafkaVersion, err)
+		}
+		config.Version = version
+	}
+	codec, ok := kafkaCompressions[strings.ToLower(es.Compression)]
+	if !ok {
+		return nil, fmt.Errorf("invalid compression %q, expected none, gzip, snappy, lz4 or zstd", es.Compression)
+	}
+	config.Producer.Compression = codec
+
+	if es.Tls != nil {
+		tlsConfig, err := streamTLSConfig(es.Tls)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+
+	if es.Sasl != nil {
+		log.Debug("Resolving Kafka credentials", "ref", es.Sasl.CredentialRef)
+		credential, err := streamCredential(es)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Handshake = true
+		config.Net.SASL.User = credential.Username
+		config.Net.SASL.Password = credential.Password
+
+		switch mechanism := strings.ToUpper(es.Sasl.Mechanism); mechanism {
+		case "", sarama.SASLTypePlaintext:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case sarama.SASLTypeSCRAMSHA256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &scramClient{hash: sha256.New} }
+		case sarama.SASLTypeSCRAMSHA512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &scramClient{hash: sha512.New} }
+		default:
+			return nil, fmt.Errorf("unsupported SASL mechanism %q, expected PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512", es.Sasl.Mechanism)
+		}
+	}
+	return config, nil
+}
+
+// kafkaDriver carries event streams over Kafka topics
+type kafkaDriver struct{}
+
+// Subscribe implements TransportDriver, joining the consumer group the options or the stream
+// name, or else consuming the selected partitions
+func (kafkaDriver) Subscribe(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error) {
+	if group := groupID(es, options); group != "" {
+		return joinKafkaGroup(ctx, es, group, options, sink)
+	}
+	return subscribePartitions(ctx, es, options, sink)
+}
+
+// NewProducer implements TransportDriver
+func (kafkaDriver) NewProducer(es *pb.EventStream, options ProducerOptions) (TransportProducer, error) {
+	log := logger.GetLogger()
+	log.Debug("Creating Kafka producer", "brokers", StreamServers(es))
+
+	config, err := kafkaConfig(es)
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+	config.Producer.Flush.Messages = options.BatchSize
+	config.Producer.Flush.Bytes = options.BatchBytes
+	config.Producer.Flush.Frequency = options.Linger
+	if (options.BatchSize > 0 || options.BatchBytes > 0) && options.Linger == 0 {
+		// Without a deadline, a batch that never fills would never be sent
+		config.Producer.Flush.Frequency = DefaultProducerLinger
+	}
+
+	producer, err := sarama.NewAsyncProducer(StreamServers(es), config)
+	if err != nil {
+		log.Error("Failed to create Kafka producer", "brokers", StreamServers(es), "error", err)
+		return nil, fmt.Errorf("failed to create Kafka producer: %v", err)
+	}
+	kp := &kafkaProducer{producer: producer, done: make(chan struct{})}
+	go kp.report()
+	return kp, nil
+}
+
+// Check implements TransportDriver, checking that the brokers are reachable and the topic exists
+func (kafkaDriver) Check(ctx context.Context, es *pb.EventStream) error {
+	config, err := kafkaConfig(es)
+	if err != nil {
+		return err
+	}
+	config.Metadata.Retry.Max = 0
+	if deadline, ok := ctx.Deadline(); ok {
+		config.Net.DialTimeout = time.Until(deadline)
+		config.Net.ReadTimeout = time.Until(deadline)
+	}
+
+	brokers := StreamServers(es)
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Kafka brokers %s: %v", strings.Join(brokers, ","), err)
+	}
+	defer client.Close()
+
+	topics, err := client.Topics()
+	if err != nil {
+		return fmt.Errorf("failed to list Kafka topics: %v", err)
+	}
+	for _, topic := range topics {
+		if topic == es.Topic {
+			return nil
+		}
+	}
+	return fmt.Errorf("Kafka topic not found: %s", es.Topic)
+}
+
+// kafkaMessage converts a message consumed from Kafka
+func kafkaMessage(msg *sarama.ConsumerMessage) *Message {
+	message := &Message{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Timestamp: msg.Timestamp,
+	}
+	for _, header := range msg.Headers {
+		if header != nil {
+			message.Headers = append(message.Headers, Header{Key: string(header.Key), Value: header.Value})
+		}
+	}
+	return message
+}
+
+// kafkaProducer sends messages to the topics of one Kafka cluster
+type kafkaProducer struct {
+	producer sarama.AsyncProducer
+	done     chan struct{} // Closed once every delivery has been reported
+}
+
+// Send implements TransportProducer
+func (p *kafkaProducer) Send(es *pb.EventStream, message *Message, report func(Delivery)) error {
+	msg := &sarama.ProducerMessage{
+		Topic:    es.Topic,
+		Value:    sarama.ByteEncoder(message.Value),
+		Metadata: report,
+	}
+	if message.Key != nil {
+		msg.Key = sarama.ByteEncoder(message.Key)
+	}
+	for _, header := range message.Headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(header.Key), Value: header.Value})
+	}
+	p.producer.Input() <- msg
+	return nil
+}
+
+// Close implements TransportProducer
+func (p *kafkaProducer) Close() error {
+	p.producer.AsyncClose()
+	<-p.done
+	return nil
+}
+
+// report reports the delivery of each message sent until the producer is closed
+func (p *kafkaProducer) report() {
+	log := logger.GetLogger()
+	defer close(p.done)
+
+	successes, errors := p.producer.Successes(), p.producer.Errors()
+	for successes != nil || errors != nil {
+		var delivery Delivery
+		var msg *sarama.ProducerMessage
+		select {
+		case success, ok := <-successes:
+			if !ok {
+				successes = nil
+				continue
+			}
+			delivery, msg = kafkaDelivery(success, nil), success
+		case err, ok := <-errors:
+			if !ok {
+				errors = nil
+				continue
+			}
+			log.Warn("Message delivery failed", "topic", err.Msg.Topic, "error", err.Err)
+			delivery, msg = kafkaDelivery(err.Msg, err.Err), err.Msg
+		}
+		if report, ok := msg.Metadata.(func(Delivery)); ok {
+			report(delivery)
+		}
+	}
+}
+
+// kafkaDelivery describes the outcome of sending a message
+func kafkaDelivery(msg *sarama.ProducerMessage, err error) Delivery {
+	delivery := Delivery{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Err:       err,
+	}
+	if err != nil {
+		delivery.Partition, delivery.Offset = -1, -1
+	}
+	if msg.Key != nil {
+		delivery.Key, _ = msg.Key.Encode()
+	}
+	if msg.Value != nil {
+		delivery.Value, _ = msg.Value.Encode()
+	}
+	return delivery
+}
+
+// scramClient authenticates with SCRAM as described in RFC 5802, adapting xdg-go/scram to
+// sarama as in sarama's own examples
+type scramClient struct {
+	hash scram.HashGeneratorFcn
+
+	conversation *scram.ClientConversation
+}
+
+// Begin implements sarama.SCRAMClient
+func (c *scramClient) Begin(username, password, authzID string) error {
+	client, err := c.hash.NewClient(username, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conversation = client.NewConversation()
+	return nil
+}
+
+// Step implements sarama.SCRAMClient
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.conversation.Step(challenge)
+}
+
+// Done implements sarama.SCRAMClient
+func (c *scramClient) Done() bool {
+	return c.conversation.Done()
+}
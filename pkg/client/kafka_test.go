@@ -0,0 +1,211 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// newMockKafka starts a broker leading every partition of a topic, each holding the given
+// messages from offset 0
+func newMockKafka(t *testing.T, topic string, partitions ...[]string) *sarama.MockBroker {
+	t.Helper()
+	broker := sarama.NewMockBroker(t, 1)
+	t.Cleanup(broker.Close)
+
+	metadata := sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID())
+	offsets := sarama.NewMockOffsetResponse(t)
+	fetch := sarama.NewMockFetchResponse(t, 1)
+	for i, messages := range partitions {
+		partition := int32(i)
+		metadata.SetLeader(topic, partition, broker.BrokerID())
+		offsets.SetOffset(topic, partition, sarama.OffsetOldest, 0)
+		offsets.SetOffset(topic, partition, sarama.OffsetNewest, int64(len(messages)))
+		for offset, message := range messages {
+			fetch.SetMessage(topic, partition, int64(offset), sarama.StringEncoder(message))
+		}
+		fetch.SetHighWaterMark(topic, partition, int64(len(messages)))
+	}
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": metadata,
+		"OffsetRequest":   offsets,
+		"FetchRequest":    fetch,
+	})
+	return broker
+}
+
+// receive reads n messages from a subscription, failing on errors or if they take too long
+func receive(t *testing.T, subscription *Subscription, n int) []*Message {
+	t.Helper()
+	var messages []*Message
+	timeout := time.After(5 * time.Second)
+	for len(messages) < n {
+		select {
+		case message := <-subscription.Messages():
+			messages = append(messages, message)
+		case err := <-subscription.Errors():
+			t.Fatal(err)
+		case <-timeout:
+			t.Fatalf("received %d of %d messages", len(messages), n)
+		}
+	}
+	return messages
+}
+
+// received formats messages as partition/offset/value, sorted as partitions are consumed
+// concurrently
+func received(messages []*Message) []string {
+	var formatted []string
+	for _, message := range messages {
+		formatted = append(formatted, fmt.Sprintf("%d/%d/%s", message.Partition, message.Offset, message.Value))
+	}
+	sort.Strings(formatted)
+	return formatted
+}
+
+func TestSubscribeAllPartitions(t *testing.T) {
+	broker := newMockKafka(t, "orders", []string{"a", "b"}, []string{"c"}, nil)
+	es := &pb.EventStream{Brokers: []string{broker.Addr()}, Topic: "orders"}
+
+	subscription, err := SubscribeEventStream(context.Background(), es, StreamOptions{Start: StartOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Close()
+
+	got := received(receive(t, subscription, 3))
+	want := []string{"0/0/a", "0/1/b", "1/0/c"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("messages = %v, want %v", got, want)
+	}
+}
+
+func TestSubscribeSelectedPartitions(t *testing.T) {
+	broker := newMockKafka(t, "orders", []string{"a", "b"}, []string{"c"})
+	es := &pb.EventStream{Brokers: []string{broker.Addr()}, Topic: "orders"}
+
+	subscription, err := SubscribeEventStream(context.Background(), es, StreamOptions{Partitions: []int32{1}, Start: StartOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Close()
+	if got := received(receive(t, subscription, 1)); got[0] != "1/0/c" {
+		t.Errorf("message of partition 1 = %v", got)
+	}
+	select {
+	case message := <-subscription.Messages():
+		t.Errorf("received %s from a partition not selected", received([]*Message{message}))
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if _, err := SubscribeEventStream(context.Background(), es, StreamOptions{Partitions: []int32{5}}); err == nil {
+		t.Error("subscribed to a partition the topic doesn't have")
+	}
+}
+
+func TestKafkaConfig(t *testing.T) {
+	t.Setenv("NEXUS_DB_KAFKA_USER", "alice")
+	t.Setenv("NEXUS_DB_KAFKA_PASSWORD", "secret")
+	es := &pb.EventStream{
+		Brokers:      []string{"a:9092", "b:9092"},
+		ClientId:     "billing",
+		KafkaVersion: "3.6.0",
+		Compression:  "zstd",
+		Tls:          &pb.KafkaTLS{ServerName: "kafka.internal"},
+		Sasl:         &pb.KafkaSASL{Mechanism: "scram-sha-512", CredentialRef: "env:KAFKA"},
+	}
+	config, err := kafkaConfig(es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.ClientID != "billing" || config.Version != sarama.V3_6_0_0 || config.Producer.Compression != sarama.CompressionZSTD {
+		t.Errorf("client ID %s, version %s, compression %s not taken from the stream", config.ClientID, config.Version, config.Producer.Compression)
+	}
+	if !config.Net.TLS.Enable || config.Net.TLS.Config.ServerName != "kafka.internal" {
+		t.Error("TLS settings not applied")
+	}
+	sasl := config.Net.SASL
+	if !sasl.Enable || sasl.Mechanism != sarama.SASLTypeSCRAMSHA512 || sasl.User != "alice" || sasl.Password != "secret" {
+		t.Errorf("SASL = %s as %s, want SCRAM-SHA-512 as alice", sasl.Mechanism, sasl.User)
+	}
+	if sasl.SCRAMClientGeneratorFunc == nil {
+		t.Error("no SCRAM client for SCRAM-SHA-512")
+	}
+
+	invalid := map[string]*pb.EventStream{
+		"no brokers":          {Topic: "t"},
+		"invalid version":     {Server: "a:9092", KafkaVersion: "latest"},
+		"invalid compression": {Server: "a:9092", Compression: "brotli"},
+		"unknown mechanism":   {Server: "a:9092", Sasl: &pb.KafkaSASL{Mechanism: "GSSAPI", CredentialRef: "env:KAFKA"}},
+		"no credential":       {Server: "a:9092", Sasl: &pb.KafkaSASL{}},
+		"missing credential":  {Server: "a:9092", Sasl: &pb.KafkaSASL{CredentialRef: "env:MISSING"}},
+	}
+	for name, es := range invalid {
+		if _, err := kafkaConfig(es); err == nil {
+			t.Errorf("%s: config created", name)
+		}
+	}
+}
+
+// TestSCRAMExchange authenticates against a SCRAM server holding the credentials derived from
+// the real password
+func TestSCRAMExchange(t *testing.T) {
+	hashes := map[string]scram.HashGeneratorFcn{"SHA-256": sha256.New, "SHA-512": sha512.New}
+	for name, hash := range hashes {
+		if err := scramExchange(t, hash, "secret"); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if err := scramExchange(t, hash, "guess"); err == nil {
+			t.Errorf("%s: wrong password accepted", name)
+		}
+	}
+}
+
+// scramExchange runs a SCRAM conversation for alice, whose password is "secret", returning
+// the error that ended it or nil if the server accepted the client
+func scramExchange(t *testing.T, hash scram.HashGeneratorFcn, password string) error {
+	t.Helper()
+	stored, err := hash.NewClient("alice", "secret", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentials := stored.GetStoredCredentials(scram.KeyFactors{Salt: "pepper", Iters: 4096})
+	server, err := hash.NewServer(func(username string) (scram.StoredCredentials, error) {
+		return credentials, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	broker := server.NewConversation()
+
+	client := &scramClient{hash: hash}
+	if err := client.Begin("alice", password, ""); err != nil {
+		t.Fatal(err)
+	}
+	challenge := ""
+	for !client.Done() {
+		response, err := client.Step(challenge)
+		if err != nil {
+			return err
+		}
+		if client.Done() {
+			break
+		}
+		if challenge, err = broker.Step(response); err != nil {
+			return err
+		}
+	}
+	if !broker.Valid() {
+		return fmt.Errorf("broker didn't accept the client")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"nexus/pkg/logger"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// Directions in which lineage graphs are followed
+const (
+	LineageUpstream   = "upstream"   // Towards the paths a node is derived from
+	LineageDownstream = "downstream" // Towards the paths derived from a node
+	LineageBoth       = "both"
+)
+
+// DeclareLineage declares the paths the node at path is derived from, replacing any declared before
+func (n *NexusClient) DeclareLineage(path string, upstream ...string) error {
+	log := logger.GetLogger()
+	log.Debug("Declaring lineage", "path", path, "upstream", upstream)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := n.Client.DeclareLineage(ctx, &pb.DeclareLineageRequest{Path: path, Upstream: upstream})
+	if err != nil {
+		log.Error("Failed to declare lineage", "error", err)
+		return err
+	}
+	if !res.Success {
+		log.Error("Failed to declare lineage", "error", res.Error)
+		return errors.New(res.Error)
+	}
+	return nil
+}
+
+// GetLineage returns the lineage graph around a path, following dependencies in the given
+// direction up to depth edges away, or without limit if depth is zero
+func (n *NexusClient) GetLineage(path string, direction string, depth int) (*pb.GetLineageResponse, error) {
+	log := logger.GetLogger()
+	log.Debug("Getting lineage", "path", path, "direction", direction, "depth", depth)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	res, err := n.Client.GetLineage(ctx, &pb.GetLineageRequest{Path: path, Direction: direction, Depth: int32(depth)})
+	if err != nil {
+		log.Error("Failed to get lineage", "error", err)
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, errors.New(res.Error)
+	}
+	return res, nil
+}
+
+// GetDependents returns the paths derived, directly or indirectly, from a path
+func (n *NexusClient) GetDependents(path string) ([]string, error) {
+	lineage, err := n.GetLineage(path, LineageDownstream, 0)
+	if err != nil {
+		return nil, err
+	}
+	var dependents []string
+	for _, node := range lineage.Nodes[1:] {
+		dependents = append(dependents, node.Path)
+	}
+	return dependents, nil
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"nexus/pkg/logger"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// mqttQoS is the quality of service messages are published and subscribed with, delivering
+// each message at least once
+const mqttQoS = 1
+
+// mqttTimeout is the longest connecting, subscribing and publishing wait without a deadline
+const mqttTimeout = 10 * time.Second
+
+// mqttDriver carries event streams over MQTT topics. Brokers only pass on messages published
+// after subscribing, messages have neither keys nor headers, and consumer groups are shared
+// subscriptions, which deliver each message to one member.
+type mqttDriver struct{}
+
+// mqttClientOptions configures a client for the brokers of an event stream. Client IDs must be unique
+// per connection, so the stream's client ID is only used as a prefix.
+func mqttClientOptions(es *pb.EventStream) (*mqtt.ClientOptions, error) {
+	servers := StreamServers(es)
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("event stream has no MQTT brokers")
+	}
+	options := mqtt.NewClientOptions()
+	for _, server := range servers {
+		if es.Tls != nil && !strings.Contains(server, "://") {
+			server = "ssl://" + server
+		}
+		options.AddBroker(server)
+	}
+	prefix := "nexus"
+	if es.ClientId != "" {
+		prefix = es.ClientId
+	}
+	options.SetClientID(uniqueName(prefix))
+	options.SetConnectTimeout(mqttTimeout)
+
+	credential, err := streamCredential(es)
+	if err != nil {
+		return nil, err
+	}
+	if credential != nil {
+		options.SetUsername(credential.Username)
+		options.SetPassword(credential.Password)
+	}
+	if es.Tls != nil {
+		tlsConfig, err := streamTLSConfig(es.Tls)
+		if err != nil {
+			return nil, err
+		}
+		options.SetTLSConfig(tlsConfig)
+	}
+	return options, nil
+}
+
+// mqttWait waits for an MQTT operation to complete, giving up when ctx is done
+func mqttWait(ctx context.Context, token mqtt.Token) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, mqttTimeout)
+		defer cancel()
+	}
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// mqttConnect connects a client to its brokers
+func mqttConnect(ctx context.Context, options *mqtt.ClientOptions) (mqtt.Client, error) {
+	client := mqtt.NewClient(options)
+	if err := mqttWait(ctx, client.Connect()); err != nil {
+		return nil, fmt.Errorf("failed to connect to MQTT brokers: %v", err)
+	}
+	return client, nil
+}
+
+// Subscribe implements TransportDriver. Messages are handled in order, so a subscriber that
+// doesn't receive holds up the connection.
+func (mqttDriver) Subscribe(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error) {
+	log := logger.GetLogger()
+	if err := onlyTail(TransportMQTT, options); err != nil {
+		return nil, err
+	}
+	filter := es.Topic
+	if group := groupID(es, options); group != "" {
+		if err := noManualCommit(TransportMQTT, options); err != nil {
+			return nil, err
+		}
+		filter = "$share/" + group + "/" + es.Topic
+	}
+
+	clientOptions, err := mqttClientOptions(es)
+	if err != nil {
+		return nil, err
+	}
+	gate := &gatedSink{sink: sink}
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		gate.Deliver(ctx, &Message{Topic: msg.Topic(), Offset: -1, Value: msg.Payload(), Timestamp: time.Now()})
+	}
+	// Clean sessions drop subscriptions, so they are renewed after reconnecting
+	var subscribed atomic.Bool
+	clientOptions.SetOnConnectHandler(func(client mqtt.Client) {
+		if subscribed.Load() {
+			if err := mqttWait(ctx, client.Subscribe(filter, mqttQoS, handler)); err != nil {
+				gate.Error(fmt.Errorf("failed to resubscribe to MQTT topic %s: %v", filter, err))
+			}
+		}
+	})
+	clientOptions.SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+		gate.Error(fmt.Errorf("lost connection to MQTT broker: %v", err))
+	})
+
+	client, err := mqttConnect(ctx, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+	if err := mqttWait(ctx, client.Subscribe(filter, mqttQoS, handler)); err != nil {
+		client.Disconnect(0)
+		return nil, fmt.Errorf("failed to subscribe to MQTT topic %s: %v", filter, err)
+	}
+	subscribed.Store(true)
+	log.Debug("Event stream consumer started", "brokers", StreamServers(es), "topic", filter)
+
+	done := make(doneConsumer)
+	go func() {
+		<-ctx.Done()
+		gate.close()
+		mqttWait(context.Background(), client.Unsubscribe(filter))
+		client.Disconnect(250)
+		log.Debug("Closed MQTT consumer", "topic", filter)
+		close(done)
+	}()
+	return done, nil
+}
+
+// NewProducer implements TransportDriver
+func (mqttDriver) NewProducer(es *pb.EventStream, options ProducerOptions) (TransportProducer, error) {
+	logger.GetLogger().Debug("Creating MQTT producer", "brokers", StreamServers(es))
+	clientOptions, err := mqttClientOptions(es)
+	if err != nil {
+		return nil, err
+	}
+	client, err := mqttConnect(context.Background(), clientOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &mqttProducer{client: client}, nil
+}
+
+// Check implements TransportDriver, checking that the brokers are reachable
+func (mqttDriver) Check(ctx context.Context, es *pb.EventStream) error {
+	clientOptions, err := mqttClientOptions(es)
+	if err != nil {
+		return err
+	}
+	client, err := mqttConnect(ctx, clientOptions)
+	if err != nil {
+		return err
+	}
+	client.Disconnect(0)
+	return nil
+}
+
+// mqttProducer publishes messages to the topics of one set of MQTT brokers
+type mqttProducer struct {
+	client  mqtt.Client
+	pending sync.WaitGroup // Messages published and not yet reported
+}
+
+// Send implements TransportProducer
+func (p *mqttProducer) Send(es *pb.EventStream, message *Message, report func(Delivery)) error {
+	if message.Key != nil || len(message.Headers) > 0 {
+		return fmt.Errorf("MQTT messages can't have keys or headers")
+	}
+	token := p.client.Publish(es.Topic, mqttQoS, false, message.Value)
+	p.pending.Add(1)
+	go func() {
+		defer p.pending.Done()
+		delivery := Delivery{Topic: es.Topic, Offset: -1, Value: message.Value}
+		if err := mqttWait(context.Background(), token); err != nil {
+			delivery.Partition, delivery.Err = -1, err
+		}
+		report(delivery)
+	}()
+	return nil
+}
+
+// Close implements TransportProducer
+func (p *mqttProducer) Close() error {
+	p.pending.Wait()
+	p.client.Disconnect(250)
+	return nil
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"nexus/pkg/logger"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsKeyHeader carries the key of messages sent over NATS, which has no keys of its own
+const natsKeyHeader = "Nexus-Key"
+
+// natsFlushTimeout is the longest the server is waited for to acknowledge what was sent
+const natsFlushTimeout = 10 * time.Second
+
+// natsDriver carries event streams over core NATS subjects. NATS doesn't retain messages, so
+// subscriptions only receive those published after they start, and consumer groups are queue
+// groups, which deliver each message to one member.
+type natsDriver struct{}
+
+// natsConnect connects to the NATS servers of an event stream, passing asynchronous errors to
+// onError if it is set
+func natsConnect(es *pb.EventStream, onError func(error)) (*nats.Conn, error) {
+	servers := StreamServers(es)
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("event stream has no NATS servers")
+	}
+	options := []nats.Option{nats.Name(consumerName(es))}
+	credential, err := streamCredential(es)
+	if err != nil {
+		return nil, err
+	}
+	if credential != nil {
+		options = append(options, nats.UserInfo(credential.Username, credential.Password))
+	}
+	if es.Tls != nil {
+		tlsConfig, err := streamTLSConfig(es.Tls)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, nats.Secure(tlsConfig))
+	}
+	if onError != nil {
+		options = append(options,
+			nats.ErrorHandler(func(_ *nats.Conn, _ *nats.Subscription, err error) { onError(err) }),
+			nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+				if err != nil {
+					onError(fmt.Errorf("disconnected from NATS: %v", err))
+				}
+			}))
+	}
+
+	conn, err := nats.Connect(strings.Join(servers, ","), options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS servers %s: %v", strings.Join(servers, ","), err)
+	}
+	return conn, nil
+}
+
+// Subscribe implements TransportDriver
+func (natsDriver) Subscribe(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error) {
+	log := logger.GetLogger()
+	if err := onlyTail(TransportNATS, options); err != nil {
+		return nil, err
+	}
+	group := groupID(es, options)
+	if group != "" {
+		if err := noManualCommit(TransportNATS, options); err != nil {
+			return nil, err
+		}
+	}
+
+	gate := &gatedSink{sink: sink}
+	conn, err := natsConnect(es, gate.Error)
+	if err != nil {
+		return nil, err
+	}
+	handler := func(msg *nats.Msg) {
+		gate.Deliver(ctx, natsMessage(msg))
+	}
+	var subscription *nats.Subscription
+	if group != "" {
+		subscription, err = conn.QueueSubscribe(es.Topic, group, handler)
+	} else {
+		subscription, err = conn.Subscribe(es.Topic, handler)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to subscribe to NATS subject %s: %v", es.Topic, err)
+	}
+	log.Debug("Event stream consumer started", "servers", StreamServers(es), "subject", es.Topic, "group", group)
+
+	done := make(doneConsumer)
+	go func() {
+		<-ctx.Done()
+		subscription.Unsubscribe()
+		gate.close()
+		conn.Close()
+		log.Debug("Closed NATS consumer", "subject", es.Topic)
+		close(done)
+	}()
+	return done, nil
+}
+
+// NewProducer implements TransportDriver. Unless the producer is asynchronous, each message is
+// reported once the server has received it.
+func (natsDriver) NewProducer(es *pb.EventStream, options ProducerOptions) (TransportProducer, error) {
+	logger.GetLogger().Debug("Creating NATS producer", "servers", StreamServers(es))
+	conn, err := natsConnect(es, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &natsProducer{conn: conn, sync: options.Mode != ProduceAsync}, nil
+}
+
+// Check implements TransportDriver, checking that the servers are reachable
+func (natsDriver) Check(ctx context.Context, es *pb.EventStream) error {
+	conn, err := natsConnect(es, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, ok := ctx.Deadline(); !ok {
+		return conn.FlushTimeout(natsFlushTimeout)
+	}
+	return conn.FlushWithContext(ctx)
+}
+
+// natsMessage converts a message received from NATS
+func natsMessage(msg *nats.Msg) *Message {
+	message := &Message{
+		Topic:     msg.Subject,
+		Offset:    -1,
+		Value:     msg.Data,
+		Timestamp: time.Now(),
+	}
+	names := make([]string, 0, len(msg.Header))
+	for name := range msg.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range msg.Header[name] {
+			if name == natsKeyHeader {
+				message.Key = []byte(value)
+				continue
+			}
+			message.Headers = append(message.Headers, Header{Key: name, Value: []byte(value)})
+		}
+	}
+	return message
+}
+
+// natsProducer publishes messages to the subjects of one set of NATS servers
+type natsProducer struct {
+	conn *nats.Conn
+	sync bool
+
+	mu sync.Mutex // Serializes publishing and flushing in sync mode
+}
+
+// Send implements TransportProducer
+func (p *natsProducer) Send(es *pb.EventStream, message *Message, report func(Delivery)) error {
+	msg := nats.NewMsg(es.Topic)
+	msg.Data = message.Value
+	if message.Key != nil {
+		msg.Header.Set(natsKeyHeader, string(message.Key))
+	}
+	for _, header := range message.Headers {
+		msg.Header.Add(header.Key, string(header.Value))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.conn.PublishMsg(msg); err != nil {
+		return fmt.Errorf("failed to publish to NATS subject %s: %v", es.Topic, err)
+	}
+	delivery := Delivery{Topic: es.Topic, Offset: -1, Key: message.Key, Value: message.Value}
+	if p.sync {
+		if err := p.conn.FlushTimeout(natsFlushTimeout); err != nil {
+			delivery.Partition, delivery.Err = -1, err
+		}
+	}
+	report(delivery)
+	return nil
+}
+
+// Close implements TransportProducer
+func (p *natsProducer) Close() error {
+	defer p.conn.Close()
+	return p.conn.FlushTimeout(natsFlushTimeout)
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+// parquetChunkSize is the number of rows read from each column at a time
+const parquetChunkSize = 1000
+
+// ParquetReader reads Parquet files with a flat schema, streaming rows in chunks
+type ParquetReader struct{}
+
+func init() {
+	RegisterReader("parquet", &ParquetReader{})
+}
+
+// Open implements DatasetReader
+func (r *ParquetReader) Open(filePath string) (RowSource, error) {
+	fileReader, err := local.NewLocalFileReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	parquetReader, err := reader.NewParquetColumnReader(fileReader, 1)
+	if err != nil {
+		fileReader.Close()
+		return nil, err
+	}
+
+	source := &parquetSource{file: fileReader, reader: parquetReader, remaining: parquetReader.GetNumRows()}
+	for _, element := range parquetReader.SchemaHandler.SchemaElements {
+		if element.GetNumChildren() == 0 {
+			source.columns = append(source.columns, Column{Name: element.GetName(), Type: parquetColumnType(element)})
+		}
+	}
+	return source, nil
+}
+
+// parquetSource streams the rows of a Parquet file, reading each column a chunk at a time
+type parquetSource struct {
+	file      source.ParquetFile
+	reader    *reader.ParquetReader
+	columns   []Column
+	remaining int64
+	chunk     [][]interface{}
+}
+
+func (s *parquetSource) Columns() []Column {
+	return s.columns
+}
+
+func (s *parquetSource) Next() ([]interface{}, error) {
+	if len(s.chunk) == 0 {
+		if err := s.readChunk(); err != nil {
+			return nil, err
+		}
+	}
+	row := s.chunk[0]
+	s.chunk = s.chunk[1:]
+	return row, nil
+}
+
+func (s *parquetSource) readChunk() error {
+	size := s.remaining
+	if size > parquetChunkSize {
+		size = parquetChunkSize
+	}
+	if size <= 0 {
+		return io.EOF
+	}
+
+	rows := make([][]interface{}, size)
+	for i := range rows {
+		rows[i] = make([]interface{}, len(s.columns))
+	}
+	for col := range s.columns {
+		values, _, _, err := s.reader.ReadColumnByIndex(int64(col), size)
+		if err != nil {
+			return err
+		}
+		if int64(len(values)) != size {
+			return fmt.Errorf("nested parquet column %s is not supported", s.columns[col].Name)
+		}
+		for row, value := range values {
+			rows[row][col] = parquetValue(value)
+		}
+	}
+
+	s.remaining -= size
+	s.chunk = rows
+	return nil
+}
+
+func (s *parquetSource) Close() error {
+	s.reader.ReadStop()
+	return s.file.Close()
+}
+
+func parquetColumnType(element *parquet.SchemaElement) string {
+	if element.Type == nil {
+		return TypeString
+	}
+	switch *element.Type {
+	case parquet.Type_BOOLEAN:
+		return TypeBool
+	case parquet.Type_INT32, parquet.Type_INT64:
+		return TypeInt
+	case parquet.Type_FLOAT, parquet.Type_DOUBLE:
+		return TypeFloat
+	default:
+		return TypeString
+	}
+}
+
+// parquetValue converts a value read from a parquet column to the Go type used by Table
+func parquetValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case []byte:
+		return string(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// ParquetWriter writes Parquet files with a flat schema of optional columns
+type ParquetWriter struct{}
+
+func init() {
+	RegisterWriter("parquet", &ParquetWriter{})
+}
+
+// Create implements DatasetWriter
+func (w *ParquetWriter) Create(filePath string, columns []Column) (RowSink, error) {
+	metadata := make([]string, len(columns))
+	for i, column := range columns {
+		if strings.ContainsAny(column.Name, ",=") {
+			return nil, fmt.Errorf("parquet column names can't contain ',' or '=': %s", column.Name)
+		}
+		metadata[i] = fmt.Sprintf("name=%s, %s, repetitiontype=OPTIONAL", column.Name, parquetType(column.Type))
+	}
+
+	fileWriter, err := local.NewLocalFileWriter(filePath)
+	if err != nil {
+		return nil, err
+	}
+	parquetWriter, err := writer.NewCSVWriter(metadata, fileWriter, 1)
+	if err != nil {
+		fileWriter.Close()
+		return nil, err
+	}
+	return &parquetSink{file: fileWriter, writer: parquetWriter, columns: columns}, nil
+}
+
+// parquetSink writes rows to a Parquet file, converting values to their column's type
+type parquetSink struct {
+	file    source.ParquetFile
+	writer  *writer.CSVWriter
+	columns []Column
+}
+
+func (s *parquetSink) Write(row []interface{}) error {
+	record := make([]interface{}, len(s.columns))
+	for i, column := range s.columns {
+		if i >= len(row) {
+			continue
+		}
+		value, ok := typedValue(row[i], column.Type)
+		if !ok {
+			return fmt.Errorf("value %q doesn't fit %s column %s", FormatValue(row[i]), column.Type, column.Name)
+		}
+		record[i] = value
+	}
+	return s.writer.Write(record)
+}
+
+func (s *parquetSink) Close() error {
+	if err := s.writer.WriteStop(); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
+
+// parquetType returns the parquet schema metadata for a column type
+func parquetType(columnType string) string {
+	switch columnType {
+	case TypeBool:
+		return "type=BOOLEAN"
+	case TypeInt:
+		return "type=INT64"
+	case TypeFloat:
+		return "type=DOUBLE"
+	default:
+		return "type=BYTE_ARRAY, convertedtype=UTF8"
+	}
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"nexus/pkg/logger"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// previewTimeout bounds GetPreview calls, which may have to read a whole dataset when the
+// server has no cached preview yet
+const previewTimeout = 5 * time.Minute
+
+// GetPreview returns the first rows and column statistics of a dataset as cached by the
+// server. Setting refresh makes the server recompute them first.
+func (n *NexusClient) GetPreview(path string, refresh bool) (*pb.GetPreviewResponse, error) {
+	log := logger.GetLogger()
+	log.Debug("Getting preview", "path", path, "refresh", refresh)
+
+	ctx, cancel := context.WithTimeout(context.Background(), previewTimeout)
+	defer cancel()
+
+	res, err := n.Client.GetPreview(ctx, &pb.GetPreviewRequest{Path: path, Refresh: refresh})
+	if err != nil {
+		log.Error("Failed to get preview", "error", err)
+		return nil, err
+	}
+	if res.Error != "" {
+		log.Error("Failed to get preview", "error", res.Error)
+		return nil, errors.New(res.Error)
+	}
+	return res, nil
+}
+
+// PreviewTable returns the rows of a preview as a table
+func PreviewTable(preview *pb.GetPreviewResponse) *Table {
+	table := &Table{Columns: ColumnsFromProto(preview.Columns)}
+	for _, row := range preview.Rows {
+		table.Rows = append(table.Rows, RowFromProto(row))
+	}
+	return table
+}
+
+// CellValue converts a protobuf cell to a table value, nil if the cell is unset
+func CellValue(cell *pb.Cell) interface{} {
+	if cell == nil {
+		return nil
+	}
+	return RowFromProto(&pb.Row{Cells: []*pb.Cell{cell}})[0]
+}
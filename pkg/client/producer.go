@@ -0,0 +1,222 @@
+package client
+
+import (
+	"fmt"
+	"nexus/pkg/logger"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// Modes a NexusClient produces messages to event streams in
+const (
+	ProduceSync  = "sync"  // Produce waits for the broker to acknowledge the message
+	ProduceAsync = "async" // Produce returns once the message is queued, its delivery is reported later
+)
+
+// DefaultProducerLinger is how long a batch waits to fill before it is sent when a batch size is
+// given without ProducerOptions.Linger
+const DefaultProducerLinger = 100 * time.Millisecond
+
+// ProducerOptions controls how a NexusClient produces messages to event streams
+type ProducerOptions struct {
+	Mode       string                  // ProduceSync or ProduceAsync, ProduceSync if empty
+	BatchSize  int                     // Messages collected before a batch is sent, if set
+	BatchBytes int                     // Bytes collected before a batch is sent, if set
+	Linger     time.Duration           // Longest a batch waits to fill before it is sent
+	OnDelivery func(delivery Delivery) // Called with the outcome of every message, mustn't call Produce
+}
+
+// Delivery reports the outcome of producing a message
+type Delivery struct {
+	Path      string // Path of the event stream the message was produced to
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Err       error // Set if the message could not be delivered
+}
+
+// producerPool shares producers between the event streams a NexusClient produces to, one
+// producer for each set of servers
+type producerPool struct {
+	mu        sync.Mutex
+	options   ProducerOptions
+	streams   map[string]*pb.EventStream // Event streams resolved so far, by path
+	producers map[string]*pooledProducer // Producers, by the transport and connection settings of their servers
+}
+
+// pooledProducer sends the messages produced to one set of servers and reports their delivery
+type pooledProducer struct {
+	producer   TransportProducer
+	onDelivery func(Delivery)
+	pending    sync.WaitGroup // Messages sent and not yet reported
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func newProducerPool() *producerPool {
+	return &producerPool{
+		streams:   map[string]*pb.EventStream{},
+		producers: map[string]*pooledProducer{},
+	}
+}
+
+// SetProducerOptions sets how messages are produced from now on. Messages already produced
+// are delivered first, by the producers they were sent with.
+func (n *NexusClient) SetProducerOptions(options ProducerOptions) error {
+	switch options.Mode {
+	case "", ProduceSync, ProduceAsync:
+	default:
+		return fmt.Errorf("invalid produce mode %q, expected %s or %s", options.Mode, ProduceSync, ProduceAsync)
+	}
+	if options.BatchSize < 0 || options.BatchBytes < 0 || options.Linger < 0 {
+		return fmt.Errorf("batch size, batch bytes and linger can't be negative")
+	}
+
+	n.producers.mu.Lock()
+	defer n.producers.mu.Unlock()
+	n.producers.closeAll()
+	n.producers.options = options
+	return nil
+}
+
+// Produce sends a message to the event stream registered at path. In ProduceSync mode, it
+// waits for the message to be acknowledged and returns its delivery. In ProduceAsync mode, it
+// returns once the message is queued, and only ProducerOptions.OnDelivery learns of its
+// delivery. Streams are resolved once per path, and producers are shared by the streams on the
+// same servers until CloseProducers is called.
+func (n *NexusClient) Produce(path string, key []byte, value []byte, headers []Header) (Delivery, error) {
+	log := logger.GetLogger()
+
+	es, producer, options, err := n.producers.get(n, path)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	var result chan Delivery
+	if options.Mode != ProduceAsync {
+		result = make(chan Delivery, 1)
+	}
+	message := &Message{Topic: es.Topic, Key: key, Value: value, Headers: headers}
+	if err := producer.send(path, es, message, result); err != nil {
+		return Delivery{}, err
+	}
+	if result == nil {
+		return Delivery{Path: path, Topic: es.Topic, Partition: -1, Offset: -1, Key: key, Value: value}, nil
+	}
+
+	delivery := <-result
+	if delivery.Err != nil {
+		log.Error("Failed to produce message", "path", path, "error", delivery.Err)
+		return delivery, delivery.Err
+	}
+	log.Debug("Message produced", "path", path, "partition", delivery.Partition, "offset", delivery.Offset)
+	return delivery, nil
+}
+
+// Flush waits until the delivery of every message produced so far has been reported
+func (n *NexusClient) Flush() {
+	n.producers.mu.Lock()
+	producers := make([]*pooledProducer, 0, len(n.producers.producers))
+	for _, producer := range n.producers.producers {
+		producers = append(producers, producer)
+	}
+	n.producers.mu.Unlock()
+
+	for _, producer := range producers {
+		producer.pending.Wait()
+	}
+}
+
+// CloseProducers delivers the messages still queued and closes the pooled producers. Producing
+// again creates new ones.
+func (n *NexusClient) CloseProducers() {
+	n.producers.mu.Lock()
+	defer n.producers.mu.Unlock()
+	n.producers.closeAll()
+}
+
+// get resolves the event stream registered at path and returns it with the producer for its
+// cluster, creating one if needed, and the options it was created with
+func (p *producerPool) get(n *NexusClient, path string) (*pb.EventStream, *pooledProducer, ProducerOptions, error) {
+	p.mu.Lock()
+	es, ok := p.streams[path]
+	p.mu.Unlock()
+	if !ok {
+		// Resolved without holding the lock, so one slow lookup doesn't hold up other streams
+		value, _, err := n.GetFull(path)
+		if err != nil {
+			return nil, nil, ProducerOptions{}, err
+		}
+		if es, ok = value.(*pb.EventStream); !ok {
+			return nil, nil, ProducerOptions{}, fmt.Errorf("no event stream registered at path: %s", path)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.streams[path] = es
+	cluster := streamCluster(es)
+	producer, ok := p.producers[cluster]
+	if !ok {
+		driver, err := GetTransport(es.Transport)
+		if err != nil {
+			return nil, nil, ProducerOptions{}, err
+		}
+		transportProducer, err := driver.NewProducer(es, p.options)
+		if err != nil {
+			return nil, nil, ProducerOptions{}, err
+		}
+		producer = &pooledProducer{producer: transportProducer, onDelivery: p.options.OnDelivery}
+		p.producers[cluster] = producer
+	}
+	return es, producer, p.options, nil
+}
+
+// closeAll closes every producer in the pool, the caller must hold p.mu
+func (p *producerPool) closeAll() {
+	for cluster, producer := range p.producers {
+		producer.close()
+		delete(p.producers, cluster)
+	}
+	p.streams = map[string]*pb.EventStream{}
+}
+
+// send queues a message for an event stream, passing its delivery to result if it is set
+func (p *pooledProducer) send(path string, es *pb.EventStream, message *Message, result chan Delivery) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return fmt.Errorf("producer for %s was closed", path)
+	}
+	p.pending.Add(1)
+	err := p.producer.Send(es, message, func(delivery Delivery) {
+		delivery.Path = path
+		if result != nil {
+			result <- delivery
+		}
+		if p.onDelivery != nil {
+			p.onDelivery(delivery)
+		}
+		p.pending.Done()
+	})
+	if err != nil {
+		p.pending.Done()
+	}
+	return err
+}
+
+// close waits for the messages queued to be delivered and closes the producer
+func (p *pooledProducer) close() {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+
+	if err := p.producer.Close(); err != nil {
+		logger.GetLogger().Warn("Failed to close producer", "error", err)
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"nexus/pkg/logger"
 	pb "nexus/pkg/proto"
@@ -21,11 +22,15 @@ func (n *NexusClient) PublishEventStream(path string, eventStream *pb.EventStrea
 		EventStream: eventStream,
 	}
 
-	_, err := n.Client.RegisterEventStream(ctx, req)
+	res, err := n.Client.RegisterEventStream(ctx, req)
 	if err != nil {
 		log.Error("Failed to publish event stream", "error", err)
 		return err
 	}
+	if res.Error != "" {
+		log.Error("Failed to publish event stream", "error", res.Error)
+		return errors.New(res.Error)
+	}
 
 	log.Debug("Event stream published successfully", "path", path)
 	return nil
@@ -100,6 +105,33 @@ func (n *NexusClient) PublishDatabaseTable(path string, table *pb.DatabaseTable)
 	return nil
 }
 
+// PublishQuery registers a query with the Nexus server
+func (n *NexusClient) PublishQuery(path string, query *pb.Query) error {
+	log := logger.GetLogger()
+	log.Debug("Publishing query", "path", path, "connection", query.ConnectionPath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	req := &pb.RegisterQueryRequest{
+		Path:  path,
+		Query: query,
+	}
+
+	res, err := n.Client.RegisterQuery(ctx, req)
+	if err != nil {
+		log.Error("Failed to publish query", "error", err)
+		return err
+	}
+	if res.Error != "" {
+		log.Error("Failed to publish query", "error", res.Error)
+		return errors.New(res.Error)
+	}
+
+	log.Debug("Query published successfully", "path", path)
+	return nil
+}
+
 // PublishValue publishes a value to the Nexus server
 func (n *NexusClient) PublishValue(path string, value interface{}) error {
 	log := logger.GetLogger()
@@ -131,6 +163,13 @@ func (n *NexusClient) PublishValue(path string, value interface{}) error {
 				FloatValue: &pb.FloatValue{Value: v},
 			},
 		}
+	case bool:
+		req = &pb.StoreValueRequest{
+			Path: path,
+			Value: &pb.StoreValueRequest_BoolValue{
+				BoolValue: &pb.BoolValue{Value: v},
+			},
+		}
 	default:
 		err := fmt.Errorf("unsupported value type: %T", value)
 		log.Error("Failed to publish value", "error", err)
@@ -0,0 +1,222 @@
+package client
+
+import (
+	"fmt"
+	"nexus/pkg/logger"
+	"strconv"
+	"strings"
+
+	pb "nexus/pkg/proto"
+)
+
+// CreateQuery creates a query that runs on the connection of the database table registered at
+// connectionPath. The statement refers to its parameters as :name.
+func CreateQuery(connectionPath string, sql string, parameters ...*pb.QueryParameter) *pb.Query {
+	log := logger.GetLogger()
+	log.Debug("Creating query", "connection", connectionPath, "parameters", len(parameters))
+	return &pb.Query{
+		ConnectionPath: connectionPath,
+		Sql:            sql,
+		Parameters:     parameters,
+	}
+}
+
+// ParseQueryParameter parses a parameter declaration such as "day:int" or "city:string=Boston".
+// The type defaults to string and the parameter is required unless a default value is given.
+func ParseQueryParameter(decl string) (*pb.QueryParameter, error) {
+	decl, defaultValue, hasDefault := strings.Cut(decl, "=")
+	name, paramType, _ := strings.Cut(decl, ":")
+	parameter := &pb.QueryParameter{Name: strings.TrimSpace(name), Type: strings.TrimSpace(paramType)}
+	if parameter.Type == "" {
+		parameter.Type = TypeString
+	}
+	if hasDefault {
+		parameter.DefaultValue = &defaultValue
+	}
+	if err := validateQueryParameter(parameter); err != nil {
+		return nil, err
+	}
+	return parameter, nil
+}
+
+// ValidateQuery checks that a query declares every parameter its statement refers to, and that
+// the declared types and default values are valid
+func ValidateQuery(query *pb.Query) error {
+	if query.ConnectionPath == "" {
+		return fmt.Errorf("no connection path given")
+	}
+	if strings.TrimSpace(query.Sql) == "" {
+		return fmt.Errorf("no SQL statement given")
+	}
+
+	declared := map[string]bool{}
+	for _, parameter := range query.Parameters {
+		if err := validateQueryParameter(parameter); err != nil {
+			return err
+		}
+		if declared[parameter.Name] {
+			return fmt.Errorf("duplicate query parameter: %s", parameter.Name)
+		}
+		declared[parameter.Name] = true
+	}
+
+	_, names := bindQueryParameters(query.Sql, func(int) string { return "?" })
+	for _, name := range names {
+		if !declared[name] {
+			return fmt.Errorf("undeclared query parameter: %s", name)
+		}
+	}
+	return nil
+}
+
+func validateQueryParameter(parameter *pb.QueryParameter) error {
+	if parameter.Name == "" || !isIdentStart(parameter.Name[0]) || strings.IndexFunc(parameter.Name, func(r rune) bool {
+		return r > 127 || !isIdentChar(byte(r))
+	}) >= 0 {
+		return fmt.Errorf("invalid query parameter name: %q", parameter.Name)
+	}
+	switch parameter.Type {
+	case TypeString, TypeInt, TypeFloat, TypeBool:
+	default:
+		return fmt.Errorf("unsupported type %q for query parameter %s", parameter.Type, parameter.Name)
+	}
+	if parameter.DefaultValue != nil {
+		if _, err := parseParameterValue(*parameter.DefaultValue, parameter.Type); err != nil {
+			return fmt.Errorf("invalid default for query parameter %s: %v", parameter.Name, err)
+		}
+	}
+	return nil
+}
+
+// OpenQuery runs a query on the connection of a database table and opens a cursor over its
+// results. Parameter values are taken from options.Parameters, falling back to the declared
+// defaults, and are passed to the database as query arguments.
+func OpenQuery(query *pb.Query, connection *pb.DatabaseTable, options ReadOptions) (*Cursor, error) {
+	log := logger.GetLogger()
+	log.Debug("Opening query", "connection", query.ConnectionPath, "type", connection.DbType, "db", connection.DbName)
+
+	if err := ValidateQuery(query); err != nil {
+		return nil, err
+	}
+
+	declared := map[string]*pb.QueryParameter{}
+	for _, parameter := range query.Parameters {
+		declared[parameter.Name] = parameter
+	}
+	for name := range options.Parameters {
+		if declared[name] == nil {
+			return nil, fmt.Errorf("unknown query parameter: %s", name)
+		}
+	}
+
+	db, driver, err := openDatabase(connection)
+	if err != nil {
+		return nil, err
+	}
+
+	statement, names := bindQueryParameters(query.Sql, driver.Placeholder)
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		parameter := declared[name]
+		value, ok := options.Parameters[name]
+		if !ok {
+			if parameter.DefaultValue == nil {
+				db.Close()
+				return nil, fmt.Errorf("missing value for query parameter: %s", name)
+			}
+			value = *parameter.DefaultValue
+		}
+		args[i], err = parseParameterValue(value, parameter.Type)
+		if err != nil {
+			db.Close()
+			return nil, fmt.Errorf("invalid value for query parameter %s: %v", name, err)
+		}
+	}
+	log.Debug("Executing query", "query", statement)
+
+	rows, err := db.Query(statement, args...)
+	if err != nil {
+		db.Close()
+		log.Error("Failed to execute query", "error", err)
+		return nil, err
+	}
+
+	source, err := newSQLSource(db, rows)
+	if err != nil {
+		rows.Close()
+		db.Close()
+		log.Error("Failed to get column names", "error", err)
+		return nil, err
+	}
+	return NewCursor(source, options)
+}
+
+// GetQueryConnection returns the database table whose connection a query runs on
+func (n *NexusClient) GetQueryConnection(query *pb.Query) (*pb.DatabaseTable, error) {
+	value, _, err := n.GetFull(query.ConnectionPath)
+	if err != nil {
+		return nil, err
+	}
+	connection, ok := value.(*pb.DatabaseTable)
+	if !ok {
+		return nil, fmt.Errorf("no database table found at connection path: %s", query.ConnectionPath)
+	}
+	return connection, nil
+}
+
+// parseParameterValue converts the textual value of a query parameter to its declared type
+func parseParameterValue(value string, paramType string) (interface{}, error) {
+	switch paramType {
+	case TypeInt:
+		return strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	case TypeFloat:
+		return strconv.ParseFloat(strings.TrimSpace(value), 64)
+	case TypeBool:
+		return strconv.ParseBool(strings.TrimSpace(value))
+	default:
+		return value, nil
+	}
+}
+
+// bindQueryParameters rewrites the :name parameter references of a statement to placeholders,
+// returning the name bound to each placeholder in order. Quoted strings and identifiers and
+// PostgreSQL :: casts are left untouched.
+func bindQueryParameters(statement string, placeholder func(int) string) (string, []string) {
+	var out strings.Builder
+	var names []string
+	for i := 0; i < len(statement); i++ {
+		c := statement[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end := strings.IndexByte(statement[i+1:], c)
+			if end < 0 {
+				out.WriteString(statement[i:])
+				return out.String(), names
+			}
+			out.WriteString(statement[i : i+end+2])
+			i += end + 1
+		case c == ':' && i+1 < len(statement) && statement[i+1] == ':':
+			out.WriteString("::")
+			i++
+		case c == ':' && i+1 < len(statement) && isIdentStart(statement[i+1]):
+			j := i + 1
+			for j < len(statement) && isIdentChar(statement[j]) {
+				j++
+			}
+			names = append(names, statement[i+1:j])
+			out.WriteString(placeholder(len(names)))
+			i = j - 1
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.String(), names
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
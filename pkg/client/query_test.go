@@ -0,0 +1,106 @@
+package client
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pb "nexus/pkg/proto"
+)
+
+func TestBindQueryParameters(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+		names     []string
+	}{
+		{"SELECT * FROM t", "SELECT * FROM t", nil},
+		{"SELECT * FROM t WHERE a = :a AND b > :b_2", "SELECT * FROM t WHERE a = $1 AND b > $2", []string{"a", "b_2"}},
+		// Every reference is a parameter of its own, even if repeated
+		{"WHERE a = :x OR b = :x", "WHERE a = $1 OR b = $2", []string{"x", "x"}},
+		{"SELECT created::date FROM t WHERE id = :id::int", "SELECT created::date FROM t WHERE id = $1::int", []string{"id"}},
+		{
+			`SELECT ':not', "col:umn", ` + "`a:b`" + ` FROM t WHERE c = :c`,
+			`SELECT ':not', "col:umn", ` + "`a:b`" + ` FROM t WHERE c = $1`,
+			[]string{"c"},
+		},
+		{"WHERE a = :a AND b = ':b", "WHERE a = $1 AND b = ':b", []string{"a"}},
+		{"SELECT '1' WHERE a = :1 OR b = :", "SELECT '1' WHERE a = :1 OR b = :", nil},
+	}
+
+	placeholder := func(n int) string { return fmt.Sprintf("$%d", n) }
+	for _, tt := range tests {
+		got, names := bindQueryParameters(tt.statement, placeholder)
+		if got != tt.want || !reflect.DeepEqual(names, tt.names) {
+			t.Errorf("bindQueryParameters(%q) = %q %v, want %q %v", tt.statement, got, names, tt.want, tt.names)
+		}
+	}
+}
+
+func TestOpenQuery(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "people.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TABLE people (id INTEGER, name TEXT, age INTEGER)"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO people VALUES (1, 'Ada', 36), (2, 'Grace', 45), (3, 'Alan', 41)"); err != nil {
+		t.Fatal(err)
+	}
+
+	minAge := "40"
+	query := &pb.Query{
+		ConnectionPath: "/db",
+		Sql:            "SELECT name FROM people WHERE age >= :min_age AND name != :skip ORDER BY id",
+		Parameters: []*pb.QueryParameter{
+			{Name: "min_age", Type: TypeInt, DefaultValue: &minAge},
+			{Name: "skip", Type: TypeString},
+		},
+	}
+	connection := &pb.DatabaseTable{DbType: "sqlite", DbName: dbPath}
+
+	// The value of skip is compared as text, not spliced into the statement
+	cursor, err := OpenQuery(query, connection, ReadOptions{Parameters: map[string]string{"skip": "x' OR '1'='1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := cursor.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]interface{}{{"Grace"}, {"Alan"}}; !reflect.DeepEqual(table.Rows, want) {
+		t.Errorf("rows = %v, want %v", table.Rows, want)
+	}
+
+	invalid := []map[string]string{
+		{},                                // skip has no default
+		{"skip": "Ada", "limit": "1"},     // Not declared
+		{"skip": "Ada", "min_age": "old"}, // Not an int
+	}
+	for _, parameters := range invalid {
+		if _, err := OpenQuery(query, connection, ReadOptions{Parameters: parameters}); err == nil {
+			t.Errorf("query ran with parameters %v", parameters)
+		}
+	}
+}
+
+func TestValidateQuery(t *testing.T) {
+	invalid := []*pb.Query{
+		{Sql: "SELECT 1"},
+		{ConnectionPath: "/db", Sql: " "},
+		{ConnectionPath: "/db", Sql: "SELECT * FROM t WHERE a = :a"},
+		{ConnectionPath: "/db", Sql: "SELECT 1", Parameters: []*pb.QueryParameter{{Name: "a", Type: "date"}}},
+		{ConnectionPath: "/db", Sql: "SELECT 1", Parameters: []*pb.QueryParameter{{Name: "1a", Type: TypeInt}}},
+		{ConnectionPath: "/db", Sql: "SELECT 1", Parameters: []*pb.QueryParameter{{Name: "a", Type: TypeInt}, {Name: "a", Type: TypeInt}}},
+	}
+	for _, query := range invalid {
+		if err := ValidateQuery(query); err == nil {
+			t.Errorf("query %v accepted", query)
+		}
+	}
+}
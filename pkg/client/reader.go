@@ -0,0 +1,204 @@
+package client
+
+import (
+	"fmt"
+	"nexus/pkg/logger"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "nexus/pkg/proto"
+)
+
+// Column describes a named, typed column of a Table
+type Column struct {
+	Name string
+	Type string // One of TypeString, TypeInt, TypeFloat or TypeBool
+}
+
+// Table is the common tabular representation returned by all dataset readers.
+// Values are nil, string, int64, float64 or bool according to the column type.
+type Table struct {
+	Columns []Column
+	Rows    [][]interface{}
+}
+
+// DatasetReader opens data files of a particular format as streams of typed rows
+type DatasetReader interface {
+	Open(filePath string) (RowSource, error)
+}
+
+var (
+	readersMu sync.RWMutex
+	readers   = map[string]DatasetReader{}
+)
+
+// RegisterReader registers a reader for a file type such as "csv" or ".csv",
+// replacing any reader previously registered for it
+func RegisterReader(fileType string, reader DatasetReader) {
+	readersMu.Lock()
+	defer readersMu.Unlock()
+	readers[normalizeFileType(fileType)] = reader
+}
+
+// GetReader returns the reader registered for a file type
+func GetReader(fileType string) (DatasetReader, error) {
+	readersMu.RLock()
+	defer readersMu.RUnlock()
+	reader, ok := readers[normalizeFileType(fileType)]
+	if !ok {
+		return nil, fmt.Errorf("no reader registered for file type: %s", fileType)
+	}
+	return reader, nil
+}
+
+// OpenTable opens a data file as a row source with the reader registered for its file type
+func OpenTable(filePath string, fileType string) (RowSource, error) {
+	log := logger.GetLogger()
+	log.Debug("Opening table", "path", filePath, "type", fileType)
+
+	reader, err := GetReader(fileType)
+	if err != nil {
+		log.Error("Failed to get reader", "error", err)
+		return nil, err
+	}
+
+	source, err := reader.Open(filePath)
+	if err != nil {
+		log.Error("Failed to open table", "path", filePath, "error", err)
+		return nil, err
+	}
+	return source, nil
+}
+
+// ReadTable reads a whole data file with the reader registered for its file type
+func ReadTable(filePath string, fileType string) (*Table, error) {
+	log := logger.GetLogger()
+	log.Debug("Reading table", "path", filePath, "type", fileType)
+
+	source, err := OpenTable(filePath, fileType)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := drainSource(source)
+	if err != nil {
+		log.Error("Failed to read table", "path", filePath, "error", err)
+		return nil, err
+	}
+
+	log.Debug("Table read successfully", "path", filePath, "columns", len(table.Columns), "rows", len(table.Rows))
+	return table, nil
+}
+
+// ReadIndividualFile reads a registered file using the reader for its declared file type
+func ReadIndividualFile(file *pb.IndividualFile) (*Table, error) {
+	return ReadTable(file.FilePath, individualFileType(file))
+}
+
+// OpenIndividualFile opens a cursor over a registered file using the reader for its declared file type
+func OpenIndividualFile(file *pb.IndividualFile, options ReadOptions) (*Cursor, error) {
+	source, err := OpenTable(file.FilePath, individualFileType(file))
+	if err != nil {
+		return nil, err
+	}
+	return NewCursor(source, options)
+}
+
+func individualFileType(file *pb.IndividualFile) string {
+	if file.FileType == "" {
+		return path.Ext(file.FilePath)
+	}
+	return file.FileType
+}
+
+// ColumnNames returns the names of the table's columns
+func (t *Table) ColumnNames() []string {
+	names := make([]string, len(t.Columns))
+	for i, column := range t.Columns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+// ColumnTypes returns the types of the table's columns
+func (t *Table) ColumnTypes() []string {
+	types := make([]string, len(t.Columns))
+	for i, column := range t.Columns {
+		types[i] = column.Type
+	}
+	return types
+}
+
+// Strings returns the table as rows of strings, with the column names as the first row
+func (t *Table) Strings() [][]string {
+	records := make([][]string, 0, len(t.Rows)+1)
+	records = append(records, t.ColumnNames())
+	for _, row := range t.Rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = FormatValue(value)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// FormatValue formats a table value as a string, rendering nil as an empty string
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// stringColumns builds typed columns from a header and the types inferred from its values
+func stringColumns(header []string, types []string) []Column {
+	finalizeTypes(types)
+	columns := make([]Column, len(header))
+	for i, name := range header {
+		columns[i] = Column{Name: name, Type: types[i]}
+	}
+	return columns
+}
+
+// stringRow converts a textual record to a row of values typed according to the columns
+func stringRow(record []string, columns []Column) []interface{} {
+	row := make([]interface{}, len(columns))
+	for i := range row {
+		if i < len(record) {
+			row[i] = convertString(record[i], columns[i].Type)
+		}
+	}
+	return row
+}
+
+// convertString converts a textual value to the Go type used for the column type
+func convertString(value string, columnType string) interface{} {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" && columnType != TypeString {
+		return nil
+	}
+	switch columnType {
+	case TypeInt:
+		if v, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			return v
+		}
+	case TypeFloat:
+		if v, err := strconv.ParseFloat(trimmed, 64); err == nil {
+			return v
+		}
+	case TypeBool:
+		if v, err := strconv.ParseBool(trimmed); err == nil {
+			return v
+		}
+	}
+	return value
+}
@@ -0,0 +1,392 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"nexus/pkg/logger"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Fields of the Redis stream entries messages are stored in
+const (
+	redisValueField  = "value"
+	redisKeyField    = "key"
+	redisHeaderField = "h:" // Prefix of header fields
+)
+
+// Limits of each read from a Redis stream
+const (
+	redisReadCount = 100
+	redisBlock     = 5 * time.Second
+)
+
+// redisProducerQueue is the number of messages a Redis producer queues before Send blocks
+const redisProducerQueue = 1024
+
+// redisDriver carries event streams over Redis streams. Streams have a single partition and
+// are positioned by entry ID, so subscriptions can start at the newest or oldest entry, a time
+// or a number of last entries, but not at an offset. Consumer groups are Redis consumer groups,
+// whose members are named after the stream's client ID, if set, to resume their pending entries.
+type redisDriver struct{}
+
+// redisConnect creates a client for the Redis servers of an event stream
+func redisConnect(es *pb.EventStream) (redis.UniversalClient, error) {
+	servers := StreamServers(es)
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("event stream has no Redis servers")
+	}
+	options := &redis.UniversalOptions{Addrs: servers, ClientName: es.ClientId}
+	credential, err := streamCredential(es)
+	if err != nil {
+		return nil, err
+	}
+	if credential != nil {
+		options.Username, options.Password = credential.Username, credential.Password
+	}
+	if es.Tls != nil {
+		if options.TLSConfig, err = streamTLSConfig(es.Tls); err != nil {
+			return nil, err
+		}
+	}
+	return redis.NewUniversalClient(options), nil
+}
+
+// Subscribe implements TransportDriver
+func (redisDriver) Subscribe(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error) {
+	log := logger.GetLogger()
+	if err := onlyPartitionZero(TransportRedis, options); err != nil {
+		return nil, err
+	}
+	if len(options.Offsets) > 0 {
+		return nil, fmt.Errorf("redis streams are positioned by entry ID and can't start at an offset")
+	}
+	client, err := redisConnect(es)
+	if err != nil {
+		return nil, err
+	}
+	// Reads block for a while, so the client is closed to interrupt them
+	go func() {
+		<-ctx.Done()
+		client.Close()
+	}()
+
+	if group := groupID(es, options); group != "" {
+		start := "$"
+		if options.Start == StartOldest {
+			start = "0"
+		}
+		err := client.XGroupCreateMkStream(ctx, es.Topic, group, start).Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			client.Close()
+			return nil, fmt.Errorf("failed to create Redis consumer group %s: %v", group, err)
+		}
+		consumer := &redisGroupConsumer{
+			client:   client,
+			stream:   es.Topic,
+			group:    group,
+			consumer: consumerName(es),
+			manual:   options.CommitMode == CommitManual,
+			done:     make(chan struct{}),
+		}
+		log.Debug("Joined Redis consumer group", "stream", es.Topic, "group", group, "consumer", consumer.consumer)
+		go consumer.run(ctx, sink)
+		return consumer, nil
+	}
+
+	backlog, lastID, err := redisStart(ctx, client, es.Topic, options)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to position Redis stream consumer: %v", err)
+	}
+	log.Debug("Event stream consumer started", "servers", StreamServers(es), "stream", es.Topic, "after", lastID)
+
+	done := make(doneConsumer)
+	go func() {
+		defer close(done)
+		defer client.Close()
+		for _, entry := range backlog {
+			if !sink.Deliver(ctx, redisMessage(es.Topic, entry)) {
+				return
+			}
+		}
+		for {
+			streams, err := client.XRead(ctx, &redis.XReadArgs{
+				Streams: []string{es.Topic, lastID},
+				Count:   redisReadCount,
+				Block:   redisBlock,
+			}).Result()
+			if !redisReadOK(ctx, err, sink) {
+				if ctx.Err() != nil {
+					return
+				}
+				continue
+			}
+			for _, stream := range streams {
+				for _, entry := range stream.Messages {
+					lastID = entry.ID
+					if !sink.Deliver(ctx, redisMessage(es.Topic, entry)) {
+						return
+					}
+				}
+			}
+		}
+	}()
+	return done, nil
+}
+
+// NewProducer implements TransportDriver
+func (redisDriver) NewProducer(es *pb.EventStream, options ProducerOptions) (TransportProducer, error) {
+	logger.GetLogger().Debug("Creating Redis producer", "servers", StreamServers(es))
+	client, err := redisConnect(es)
+	if err != nil {
+		return nil, err
+	}
+	producer := &redisProducer{
+		client: client,
+		queue:  make(chan redisSend, redisProducerQueue),
+		done:   make(chan struct{}),
+	}
+	go producer.run()
+	return producer, nil
+}
+
+// Check implements TransportDriver, checking that the servers are reachable
+func (redisDriver) Check(ctx context.Context, es *pb.EventStream) error {
+	client, err := redisConnect(es)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to reach Redis servers %s: %v", strings.Join(StreamServers(es), ","), err)
+	}
+	return nil
+}
+
+// redisStart resolves the entries a subscription starts with and the ID it reads after
+func redisStart(ctx context.Context, client redis.UniversalClient, stream string, options StreamOptions) ([]redis.XMessage, string, error) {
+	switch {
+	case !options.Since.IsZero():
+		// Entry IDs start with their time in milliseconds, so this is the last ID before since
+		return nil, fmt.Sprintf("%d-%d", options.Since.UnixMilli()-1, uint64(math.MaxUint64)), nil
+	case options.Start == StartOldest:
+		return nil, "0-0", nil
+	}
+
+	count := max(options.LastN, 1)
+	entries, err := client.XRevRangeN(ctx, stream, "+", "-", count).Result()
+	if err != nil {
+		return nil, "", err
+	}
+	if len(entries) == 0 {
+		return nil, "0-0", nil
+	}
+	lastID := entries[0].ID
+	if options.LastN == 0 {
+		return nil, lastID, nil
+	}
+	slices.Reverse(entries)
+	return entries, lastID, nil
+}
+
+// redisReadOK reports whether a read returned entries, passing errors other than the read
+// timing out or the subscription ending to sink and pausing before the next attempt
+func redisReadOK(ctx context.Context, err error, sink MessageSink) bool {
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, redis.Nil) || ctx.Err() != nil {
+		return false
+	}
+	logger.GetLogger().Error("Failed to read Redis stream", "error", err)
+	sink.Error(err)
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+	}
+	return false
+}
+
+// redisMessage converts a Redis stream entry. Entries without a value field, added by other
+// clients, have their fields encoded as a JSON object for a value.
+func redisMessage(stream string, entry redis.XMessage) *Message {
+	message := &Message{Topic: stream, Offset: -1, Timestamp: redisTime(entry.ID)}
+	fields := make([]string, 0, len(entry.Values))
+	for field := range entry.Values {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	value, ok := entry.Values[redisValueField]
+	if !ok {
+		message.Value, _ = json.Marshal(entry.Values)
+		return message
+	}
+	message.Value = []byte(fmt.Sprint(value))
+	for _, field := range fields {
+		value := []byte(fmt.Sprint(entry.Values[field]))
+		switch {
+		case field == redisKeyField:
+			message.Key = value
+		case strings.HasPrefix(field, redisHeaderField):
+			message.Headers = append(message.Headers, Header{Key: strings.TrimPrefix(field, redisHeaderField), Value: value})
+		}
+	}
+	return message
+}
+
+// redisTime returns the time an entry was added, from the milliseconds its ID starts with
+func redisTime(id string) time.Time {
+	ms, _, _ := strings.Cut(id, "-")
+	n, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(n)
+}
+
+// redisGroupConsumer reads a Redis stream as a member of a consumer group, acknowledging
+// entries as they are delivered, or on Commit with CommitManual
+type redisGroupConsumer struct {
+	client   redis.UniversalClient
+	stream   string
+	group    string
+	consumer string
+	manual   bool
+	done     chan struct{}
+
+	mu        sync.Mutex
+	delivered []string // IDs of the entries delivered and not yet acknowledged
+}
+
+// Done implements Consumer
+func (c *redisGroupConsumer) Done() <-chan struct{} {
+	return c.done
+}
+
+// Commit implements Consumer, acknowledging every entry delivered so far
+func (c *redisGroupConsumer) Commit() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.delivered) == 0 {
+		return nil
+	}
+	if err := c.client.XAck(context.Background(), c.stream, c.group, c.delivered...).Err(); err != nil {
+		return fmt.Errorf("failed to acknowledge Redis entries: %v", err)
+	}
+	c.delivered = nil
+	return nil
+}
+
+// run delivers the entries pending for this consumer, then new entries, until ctx is done
+func (c *redisGroupConsumer) run(ctx context.Context, sink MessageSink) {
+	log := logger.GetLogger()
+	defer close(c.done)
+	defer c.client.Close()
+
+	readID := "0"
+	for {
+		streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.consumer,
+			Streams:  []string{c.stream, readID},
+			Count:    redisReadCount,
+			Block:    redisBlock,
+		}).Result()
+		if !redisReadOK(ctx, err, sink) {
+			if ctx.Err() != nil {
+				log.Debug("Leaving Redis consumer group", "group", c.group)
+				return
+			}
+			continue
+		}
+
+		read := 0
+		for _, stream := range streams {
+			for _, entry := range stream.Messages {
+				read++
+				if c.manual {
+					// Recorded before delivery, so that a Commit right after receiving the entry covers it
+					c.mu.Lock()
+					c.delivered = append(c.delivered, entry.ID)
+					c.mu.Unlock()
+				}
+				if !sink.Deliver(ctx, redisMessage(c.stream, entry)) {
+					if c.manual {
+						c.mu.Lock()
+						c.delivered = slices.DeleteFunc(c.delivered, func(id string) bool { return id == entry.ID })
+						c.mu.Unlock()
+					}
+					return
+				}
+				if !c.manual {
+					if err := c.client.XAck(ctx, c.stream, c.group, entry.ID).Err(); err != nil && ctx.Err() == nil {
+						sink.Error(fmt.Errorf("failed to acknowledge Redis entry %s: %v", entry.ID, err))
+					}
+				}
+			}
+		}
+		if read == 0 && readID == "0" {
+			// Every pending entry was delivered, so read entries no member has received yet
+			readID = ">"
+		}
+	}
+}
+
+// redisSend is a message queued for a Redis producer
+type redisSend struct {
+	es      *pb.EventStream
+	message *Message
+	report  func(Delivery)
+}
+
+// redisProducer adds messages to the streams of one set of Redis servers, in the order sent
+type redisProducer struct {
+	client redis.UniversalClient
+	queue  chan redisSend
+	done   chan struct{} // Closed once every message queued has been reported
+}
+
+// Send implements TransportProducer
+func (p *redisProducer) Send(es *pb.EventStream, message *Message, report func(Delivery)) error {
+	p.queue <- redisSend{es: es, message: message, report: report}
+	return nil
+}
+
+// Close implements TransportProducer
+func (p *redisProducer) Close() error {
+	close(p.queue)
+	<-p.done
+	return p.client.Close()
+}
+
+// run adds each queued message to its stream and reports its delivery
+func (p *redisProducer) run() {
+	defer close(p.done)
+	for send := range p.queue {
+		values := []interface{}{redisValueField, send.message.Value}
+		if send.message.Key != nil {
+			values = append(values, redisKeyField, send.message.Key)
+		}
+		for _, header := range send.message.Headers {
+			values = append(values, redisHeaderField+header.Key, header.Value)
+		}
+		delivery := Delivery{Topic: send.es.Topic, Offset: -1, Key: send.message.Key, Value: send.message.Value}
+		if err := p.client.XAdd(context.Background(), &redis.XAddArgs{Stream: send.es.Topic, Values: values}).Err(); err != nil {
+			logger.GetLogger().Warn("Message delivery failed", "stream", send.es.Topic, "error", err)
+			delivery.Partition, delivery.Err = -1, err
+		}
+		send.report(delivery)
+	}
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"nexus/pkg/logger"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// RelayEventStream subscribes to the event stream registered at path through the Nexus server,
+// which consumes the topic on the client's behalf, for clients that can't reach the stream's
+// servers. The options select messages as they do for SubscribeEventStream, except that
+// CommitManual isn't supported and the server only joins the consumer groups it allows.
+// Subscriptions tailing the same partitions share one consumer on the server, which ends the
+// subscriptions that fall too far behind.
+func (n *NexusClient) RelayEventStream(ctx context.Context, path string, options StreamOptions) (*Subscription, error) {
+	log := logger.GetLogger()
+	log.Debug("Relaying event stream", "path", path)
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	if options.CommitMode == CommitManual {
+		return nil, fmt.Errorf("relayed event streams can't be committed manually")
+	}
+
+	subscription, ctx := newSubscription(ctx, options)
+	stream, err := n.Client.RelayEventStream(ctx, NewRelayEventStreamRequest(path, options))
+	if err != nil {
+		subscription.cancel()
+		log.Error("Failed to relay event stream", "path", path, "error", err)
+		return nil, err
+	}
+
+	// The first response tells whether the server could subscribe, so errors opening the stream
+	// are returned here rather than delivered
+	first, err := stream.Recv()
+	if err != nil {
+		subscription.cancel()
+		if err == io.EOF {
+			err = fmt.Errorf("relay of %s ended before it started", path)
+		}
+		log.Error("Failed to relay event stream", "path", path, "error", err)
+		return nil, err
+	}
+	if first.Error != "" && first.Topic == "" {
+		subscription.cancel()
+		return nil, fmt.Errorf("server error: %s", first.Error)
+	}
+
+	go func() {
+		defer subscription.finish()
+		for res := first; ; {
+			if res.Error != "" {
+				subscription.reportError(fmt.Errorf("server error: %s", res.Error))
+			} else if res.Topic != "" && !subscription.send(ctx, MessageFromProto(res)) {
+				return
+			}
+			if res, err = stream.Recv(); err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Error("Event stream relay ended", "path", path, "error", err)
+					subscription.reportError(err)
+				}
+				return
+			}
+		}
+	}()
+	return subscription, nil
+}
+
+// NewRelayEventStreamRequest builds the request relaying an event stream with the given options
+func NewRelayEventStreamRequest(path string, options StreamOptions) *pb.RelayEventStreamRequest {
+	req := &pb.RelayEventStreamRequest{
+		Path:       path,
+		Partitions: options.Partitions,
+		Start:      options.Start,
+		LastN:      options.LastN,
+		Offsets:    options.Offsets,
+		GroupId:    options.GroupID,
+		Standalone: options.Standalone,
+	}
+	if !options.Since.IsZero() {
+		req.Since = options.Since.UnixMilli()
+	}
+	return req
+}
+
+// StreamOptionsFromProto returns the stream options of a RelayEventStream request
+func StreamOptionsFromProto(req *pb.RelayEventStreamRequest) StreamOptions {
+	options := StreamOptions{
+		Partitions: req.Partitions,
+		Start:      req.Start,
+		LastN:      req.LastN,
+		Offsets:    req.Offsets,
+		GroupID:    req.GroupId,
+		Standalone: req.Standalone,
+	}
+	if req.Since != 0 {
+		options.Since = time.UnixMilli(req.Since)
+	}
+	return options
+}
+
+// MessageToProto converts a consumed message to its protobuf representation
+func MessageToProto(message *Message) *pb.RelayMessage {
+	res := &pb.RelayMessage{
+		Topic:     message.Topic,
+		Partition: message.Partition,
+		Offset:    message.Offset,
+		Key:       message.Key,
+		Value:     message.Value,
+		Timestamp: message.Timestamp.UnixMilli(),
+	}
+	for _, header := range message.Headers {
+		res.Headers = append(res.Headers, &pb.MessageHeader{Key: header.Key, Value: header.Value})
+	}
+	return res
+}
+
+// MessageFromProto converts a relayed message back to a consumed message
+func MessageFromProto(res *pb.RelayMessage) *Message {
+	message := &Message{
+		Topic:     res.Topic,
+		Partition: res.Partition,
+		Offset:    res.Offset,
+		Key:       res.Key,
+		Value:     res.Value,
+		Timestamp: time.UnixMilli(res.Timestamp),
+	}
+	for _, header := range res.Headers {
+		message.Headers = append(message.Headers, Header{Key: header.Key, Value: header.Value})
+	}
+	return message
+}
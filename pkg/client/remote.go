@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"nexus/pkg/logger"
+
+	pb "nexus/pkg/proto"
+)
+
+// ReadDataset opens a cursor over a dataset whose rows are read by the Nexus server and
+// streamed back, so the caller never needs direct access to the file or database.
+// The directory options only apply to directory datasets.
+func (n *NexusClient) ReadDataset(path string, options ReadOptions, dirOptions DirectoryReadOptions) (*Cursor, error) {
+	cursor, _, err := n.readDataset(NewReadDatasetRequest(path, options, dirOptions), options)
+	return cursor, err
+}
+
+// ErrNotModified is returned by ReadDatasetIfChanged when a dataset didn't change
+var ErrNotModified = errors.New("dataset not modified")
+
+// ReadDatasetIfChanged reads a file or directory dataset through the server unless its
+// fingerprint hash still equals hash, returning ErrNotModified in that case. It also returns
+// the dataset's current fingerprint, whose hash can be passed to the next call. Other datasets
+// have no fingerprint and are always read.
+func (n *NexusClient) ReadDatasetIfChanged(path string, hash string, options ReadOptions, dirOptions DirectoryReadOptions) (*Cursor, *pb.Fingerprint, error) {
+	req := NewReadDatasetRequest(path, options, dirOptions)
+	req.IfChangedFrom = hash
+	return n.readDataset(req, options)
+}
+
+func (n *NexusClient) readDataset(req *pb.ReadDatasetRequest, options ReadOptions) (*Cursor, *pb.Fingerprint, error) {
+	log := logger.GetLogger()
+	log.Debug("Reading dataset through server", "path", req.Path)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := n.Client.ReadDataset(ctx, req)
+	if err != nil {
+		cancel()
+		log.Error("Failed to read dataset", "error", err)
+		return nil, nil, err
+	}
+
+	// The first response carries the columns and fingerprint
+	res, err := stream.Recv()
+	if err == nil && res.Error != "" {
+		err = errors.New(res.Error)
+	}
+	if err != nil {
+		cancel()
+		log.Error("Failed to read dataset", "error", err)
+		return nil, nil, err
+	}
+	if res.NotModified {
+		cancel()
+		log.Debug("Dataset not modified", "path", req.Path)
+		return nil, res.Fingerprint, ErrNotModified
+	}
+
+	source := &remoteSource{stream: stream, cancel: cancel, columns: ColumnsFromProto(res.Columns)}
+	source.pending = res.Rows
+	// The server already applied the read options
+	cursor, err := NewCursor(source, ReadOptions{BatchSize: options.BatchSize})
+	return cursor, res.Fingerprint, err
+}
+
+// NewReadDatasetRequest builds a ReadDataset request from read options
+func NewReadDatasetRequest(path string, options ReadOptions, dirOptions DirectoryReadOptions) *pb.ReadDatasetRequest {
+	req := &pb.ReadDatasetRequest{
+		Path:         path,
+		Columns:      options.Columns,
+		Offset:       options.Offset,
+		Limit:        options.Limit,
+		BatchSize:    int32(options.BatchSize),
+		Pattern:      dirOptions.Pattern,
+		SourceColumn: dirOptions.SourceColumn,
+		Partitioned:  dirOptions.Partitioned,
+		Parameters:   options.Parameters,
+	}
+	for _, filter := range options.Filters {
+		req.Filters = append(req.Filters, &pb.Filter{Column: filter.Column, Op: filter.Op, Value: FormatValue(filter.Value)})
+	}
+	return req
+}
+
+// ReadOptionsFromProto returns the read and directory options of a ReadDataset request
+func ReadOptionsFromProto(req *pb.ReadDatasetRequest) (ReadOptions, DirectoryReadOptions) {
+	options := ReadOptions{
+		Columns:    req.Columns,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+		BatchSize:  int(req.BatchSize),
+		Parameters: req.Parameters,
+	}
+	for _, filter := range req.Filters {
+		options.Filters = append(options.Filters, Filter{Column: filter.Column, Op: filter.Op, Value: filter.Value})
+	}
+	dirOptions := DirectoryReadOptions{
+		Pattern:      req.Pattern,
+		SourceColumn: req.SourceColumn,
+		Partitioned:  req.Partitioned,
+	}
+	return options, dirOptions
+}
+
+// ColumnsToProto converts table columns to their protobuf representation
+func ColumnsToProto(columns []Column) []*pb.ColumnInfo {
+	infos := make([]*pb.ColumnInfo, len(columns))
+	for i, column := range columns {
+		infos[i] = &pb.ColumnInfo{Name: column.Name, Type: column.Type}
+	}
+	return infos
+}
+
+// ColumnsFromProto converts protobuf column descriptions to table columns
+func ColumnsFromProto(infos []*pb.ColumnInfo) []Column {
+	columns := make([]Column, len(infos))
+	for i, info := range infos {
+		columns[i] = Column{Name: info.Name, Type: info.Type}
+	}
+	return columns
+}
+
+// RowToProto converts a table row to its protobuf representation
+func RowToProto(row []interface{}) *pb.Row {
+	cells := make([]*pb.Cell, len(row))
+	for i, value := range row {
+		cell := &pb.Cell{}
+		switch v := value.(type) {
+		case nil:
+		case string:
+			cell.Value = &pb.Cell_StringValue{StringValue: v}
+		case int64:
+			cell.Value = &pb.Cell_IntValue{IntValue: v}
+		case float64:
+			cell.Value = &pb.Cell_FloatValue{FloatValue: v}
+		case bool:
+			cell.Value = &pb.Cell_BoolValue{BoolValue: v}
+		default:
+			cell.Value = &pb.Cell_StringValue{StringValue: FormatValue(v)}
+		}
+		cells[i] = cell
+	}
+	return &pb.Row{Cells: cells}
+}
+
+// RowFromProto converts a protobuf row to a table row
+func RowFromProto(row *pb.Row) []interface{} {
+	values := make([]interface{}, len(row.Cells))
+	for i, cell := range row.Cells {
+		switch v := cell.Value.(type) {
+		case *pb.Cell_StringValue:
+			values[i] = v.StringValue
+		case *pb.Cell_IntValue:
+			values[i] = v.IntValue
+		case *pb.Cell_FloatValue:
+			values[i] = v.FloatValue
+		case *pb.Cell_BoolValue:
+			values[i] = v.BoolValue
+		}
+	}
+	return values
+}
+
+// remoteSource streams the rows of a ReadDataset response stream
+type remoteSource struct {
+	stream  pb.NexusService_ReadDatasetClient
+	cancel  context.CancelFunc
+	columns []Column
+	pending []*pb.Row
+}
+
+func (s *remoteSource) Columns() []Column {
+	return s.columns
+}
+
+func (s *remoteSource) Next() ([]interface{}, error) {
+	for len(s.pending) == 0 {
+		res, err := s.stream.Recv()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		if res.Error != "" {
+			return nil, errors.New(res.Error)
+		}
+		s.pending = res.Rows
+	}
+	row := s.pending[0]
+	s.pending = s.pending[1:]
+	return RowFromProto(row), nil
+}
+
+func (s *remoteSource) Close() error {
+	s.cancel()
+	return nil
+}
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"nexus/pkg/logger"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	pb "nexus/pkg/proto"
+)
+
+// Column types used by schema inference and dataset readers
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeFloat  = "float"
+	TypeBool   = "bool"
+)
+
+// FileSchema describes the columns, row count and size of a data file
+type FileSchema struct {
+	ColumnNames []string
+	ColumnTypes []string
+	RowCount    int64
+	FileSize    int64
+}
+
+// InferFileSchema reads a data file with the reader for its file type and reports its schema
+func InferFileSchema(filePath string, fileType string) (*FileSchema, error) {
+	log := logger.GetLogger()
+	log.Debug("Inferring file schema", "path", filePath, "type", fileType)
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		log.Error("Failed to stat file", "error", err)
+		return nil, err
+	}
+
+	source, err := OpenTable(filePath, fileType)
+	if err != nil {
+		log.Error("Failed to infer file schema", "path", filePath, "error", err)
+		return nil, err
+	}
+	defer source.Close()
+
+	columns := &Table{Columns: source.Columns()}
+	schema := &FileSchema{
+		ColumnNames: columns.ColumnNames(),
+		ColumnTypes: columns.ColumnTypes(),
+		FileSize:    info.Size(),
+	}
+	for {
+		if _, err := source.Next(); err == io.EOF {
+			break
+		} else if err != nil {
+			log.Error("Failed to infer file schema", "path", filePath, "error", err)
+			return nil, err
+		}
+		schema.RowCount++
+	}
+	log.Debug("Inferred file schema", "path", filePath, "columns", schema.ColumnNames, "types", schema.ColumnTypes, "rows", schema.RowCount)
+	return schema, nil
+}
+
+// InferIndividualFile fills in the column names, types, row count and size of an individual file
+func InferIndividualFile(file *pb.IndividualFile) error {
+	schema, err := InferFileSchema(file.FilePath, file.FileType)
+	if err != nil {
+		return err
+	}
+
+	file.ColumnNames = schema.ColumnNames
+	file.ColumnTypes = schema.ColumnTypes
+	file.RowCount = schema.RowCount
+	file.FileSize = schema.FileSize
+	return nil
+}
+
+// InferDirectory fills in the shared schema of a directory and flags any files whose schema
+// differs. Files in partition subdirectories are included, by their path relative to the
+// directory, while hidden files and directories are skipped as they are when reading.
+func InferDirectory(directory *pb.Directory) error {
+	log := logger.GetLogger()
+	log.Debug("Inferring directory schema", "path", directory.DirectoryPath)
+
+	root := directory.DirectoryPath
+	var files []string
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != root && isHiddenName(entry.Name()) {
+			return skipEntry(entry)
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(relPath))
+		return nil
+	})
+	if err != nil {
+		log.Error("Failed to read directory", "error", err)
+		return err
+	}
+
+	var reference *FileSchema
+	var rowCount, totalSize int64
+	mismatched := []string{}
+	for _, file := range files {
+		if path.Ext(file) != directory.FileType {
+			log.Debug("File type differs from directory", "file", file, "type", directory.FileType)
+			mismatched = append(mismatched, file)
+			continue
+		}
+
+		schema, err := InferFileSchema(filepath.Join(root, filepath.FromSlash(file)), directory.FileType)
+		if err != nil {
+			log.Debug("Failed to infer schema of file", "file", file, "error", err)
+			mismatched = append(mismatched, file)
+			continue
+		}
+
+		rowCount += schema.RowCount
+		totalSize += schema.FileSize
+		if reference == nil {
+			reference = schema
+			continue
+		}
+		if !schemasMatch(reference, schema) {
+			log.Debug("File schema differs from directory", "file", file, "columns", schema.ColumnNames)
+			mismatched = append(mismatched, file)
+			continue
+		}
+		reference.ColumnTypes = mergeColumnTypes(reference.ColumnTypes, schema.ColumnTypes)
+	}
+
+	if reference == nil {
+		return fmt.Errorf("no readable %s files found in directory", directory.FileType)
+	}
+
+	directory.ColumnNames = reference.ColumnNames
+	directory.ColumnTypes = reference.ColumnTypes
+	directory.RowCount = rowCount
+	directory.TotalSize = totalSize
+	directory.MismatchedFiles = mismatched
+
+	log.Debug("Inferred directory schema", "path", directory.DirectoryPath, "columns", directory.ColumnNames, "mismatched", mismatched)
+	return nil
+}
+
+// normalizeFileType strips the leading dot and lowercases a file type such as ".CSV"
+func normalizeFileType(fileType string) string {
+	return strings.ToLower(strings.TrimPrefix(fileType, "."))
+}
+
+// schemasMatch reports whether two schemas have the same columns with compatible types
+func schemasMatch(a, b *FileSchema) bool {
+	if !reflect.DeepEqual(a.ColumnNames, b.ColumnNames) {
+		return false
+	}
+	for i := range a.ColumnTypes {
+		if mergeType(a.ColumnTypes[i], b.ColumnTypes[i]) == TypeString && a.ColumnTypes[i] != b.ColumnTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeColumnTypes(a, b []string) []string {
+	merged := make([]string, len(a))
+	for i := range a {
+		merged[i] = mergeType(a[i], b[i])
+	}
+	return merged
+}
+
+// mergeType returns the narrowest type able to hold values of both types
+func mergeType(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "" || a == b:
+		return a
+	case (a == TypeInt && b == TypeFloat) || (a == TypeFloat && b == TypeInt):
+		return TypeFloat
+	default:
+		return TypeString
+	}
+}
+
+// inferStringType infers the type of a textual value, returning "" for empty values
+func inferStringType(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return TypeInt
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return TypeFloat
+	}
+	if _, err := strconv.ParseBool(value); err == nil {
+		return TypeBool
+	}
+	return TypeString
+}
+
+// finalizeTypes replaces the types of columns that only held empty values with string
+func finalizeTypes(types []string) []string {
+	for i, t := range types {
+		if t == "" {
+			types[i] = TypeString
+		}
+	}
+	return types
+}
@@ -0,0 +1,271 @@
+package client
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// SecretsKeyEnv names the environment variable holding the passphrase of the secrets file
+const SecretsKeyEnv = "NEXUS_SECRETS_KEY"
+
+// SecretsFileEnv names the environment variable overriding the location of the secrets file
+const SecretsFileEnv = "NEXUS_SECRETS_FILE"
+
+// ErrSecretsKeyMissing is returned when a secrets file has to be decrypted without a passphrase
+var ErrSecretsKeyMissing = fmt.Errorf("%s environment variable is not set", SecretsKeyEnv)
+
+// secretsFile is the on-disk format of the secrets file. The credentials are encrypted with
+// AES-256-GCM using a key derived from the passphrase with scrypt.
+type secretsFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// DefaultSecretsPath returns the location of the local secrets file, $NEXUS_SECRETS_FILE if
+// set and otherwise nexus/secrets.enc in the user's config directory
+func DefaultSecretsPath() (string, error) {
+	if path := os.Getenv(SecretsFileEnv); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "nexus", "secrets.enc"), nil
+}
+
+// LoadSecrets decrypts the credentials stored in a secrets file. A missing file holds no credentials.
+func LoadSecrets(path string, passphrase string) (map[string]Credential, error) {
+	log := logger.GetLogger()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]Credential{}, nil
+	}
+	if err != nil {
+		log.Error("Failed to read secrets file", "path", path, "error", err)
+		return nil, err
+	}
+
+	var file secretsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid secrets file %s: %v", path, err)
+	}
+	if file.Version != 1 {
+		return nil, fmt.Errorf("unsupported secrets file version: %d", file.Version)
+	}
+
+	gcm, err := secretsCipher(passphrase, file.Salt)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secrets file %s: wrong key or corrupted file", path)
+	}
+
+	secrets := map[string]Credential{}
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("invalid secrets file %s: %v", path, err)
+	}
+	return secrets, nil
+}
+
+// SaveSecrets encrypts credentials into a secrets file readable only by the current user
+func SaveSecrets(path string, passphrase string, secrets map[string]Credential) error {
+	log := logger.GetLogger()
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	file := secretsFile{Version: 1, Salt: make([]byte, 16)}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	gcm, err := secretsCipher(passphrase, file.Salt)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, plaintext, nil)
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		log.Error("Failed to create secrets directory", "error", err)
+		return err
+	}
+
+	// Write to a temporary file first so a failed write never loses the existing secrets
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		log.Error("Failed to write secrets file", "path", tmp, "error", err)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// SetSecret stores a credential under a name in the default secrets file
+func SetSecret(name string, credential Credential, passphrase string) error {
+	return updateSecrets(passphrase, func(secrets map[string]Credential) error {
+		secrets[name] = credential
+		return nil
+	})
+}
+
+// DeleteSecret removes a named credential from the default secrets file
+func DeleteSecret(name string, passphrase string) error {
+	return updateSecrets(passphrase, func(secrets map[string]Credential) error {
+		if _, ok := secrets[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrCredentialNotFound, name)
+		}
+		delete(secrets, name)
+		return nil
+	})
+}
+
+// ListSecrets returns the names and usernames of the credentials in the default secrets file
+func ListSecrets(passphrase string) ([]string, map[string]string, error) {
+	path, err := DefaultSecretsPath()
+	if err != nil {
+		return nil, nil, err
+	}
+	secrets, err := LoadSecrets(path, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(secrets))
+	usernames := map[string]string{}
+	for name, credential := range secrets {
+		names = append(names, name)
+		usernames[name] = credential.Username
+	}
+	sort.Strings(names)
+	return names, usernames, nil
+}
+
+func updateSecrets(passphrase string, update func(map[string]Credential) error) error {
+	path, err := DefaultSecretsPath()
+	if err != nil {
+		return err
+	}
+	secrets, err := LoadSecrets(path, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := update(secrets); err != nil {
+		return err
+	}
+	return SaveSecrets(path, passphrase, secrets)
+}
+
+// derivedKey is the last key derived from a passphrase, as scrypt is deliberately slow
+var derivedKey struct {
+	sync.Mutex
+	passphrase string
+	salt       []byte
+	key        []byte
+}
+
+func secretsCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, ErrSecretsKeyMissing
+	}
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveKey derives the key of a secrets file from its passphrase and salt, reusing the last
+// key derived if neither changed
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	derivedKey.Lock()
+	defer derivedKey.Unlock()
+	if derivedKey.key != nil && derivedKey.passphrase == passphrase && bytes.Equal(derivedKey.salt, salt) {
+		return derivedKey.key, nil
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	derivedKey.passphrase, derivedKey.salt, derivedKey.key = passphrase, bytes.Clone(salt), key
+	return key, nil
+}
+
+// loadedSecrets is the secrets file last decrypted by SecretsFileProvider, reused until the
+// file is modified or the passphrase changes
+var loadedSecrets struct {
+	sync.Mutex
+	path       string
+	passphrase string
+	modTime    time.Time
+	size       int64
+	secrets    map[string]Credential
+}
+
+// SecretsFileProvider looks up credentials in the default secrets file, decrypted with the
+// passphrase in $NEXUS_SECRETS_KEY. The decrypted file is kept in memory until it is modified.
+type SecretsFileProvider struct{}
+
+func (SecretsFileProvider) Lookup(name string) (*Credential, error) {
+	path, err := DefaultSecretsPath()
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrCredentialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase := os.Getenv(SecretsKeyEnv)
+	loadedSecrets.Lock()
+	defer loadedSecrets.Unlock()
+	if loadedSecrets.secrets == nil || loadedSecrets.path != path || loadedSecrets.passphrase != passphrase ||
+		!loadedSecrets.modTime.Equal(info.ModTime()) || loadedSecrets.size != info.Size() {
+		secrets, err := LoadSecrets(path, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		loadedSecrets.path, loadedSecrets.passphrase = path, passphrase
+		loadedSecrets.modTime, loadedSecrets.size = info.ModTime(), info.Size()
+		loadedSecrets.secrets = secrets
+	}
+	secrets := loadedSecrets.secrets
+	credential, ok := secrets[name]
+	if !ok {
+		return nil, ErrCredentialNotFound
+	}
+	return &credential, nil
+}
@@ -0,0 +1,358 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"nexus/pkg/logger"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/IBM/sarama"
+)
+
+// DefaultPartitionRefresh is how often a stream consuming all partitions checks its topic for
+// new ones when StreamOptions.PartitionRefresh is not set
+const DefaultPartitionRefresh = 30 * time.Second
+
+// Positions an event stream can start consuming each partition from
+const (
+	StartNewest = "newest" // Only messages produced after the stream starts
+	StartOldest = "oldest" // Every message the topic still retains
+)
+
+// StreamOptions controls which messages of an event stream are consumed. At most one of
+// Start, Since and LastN may be set, and Offsets overrides them for the partitions it lists.
+type StreamOptions struct {
+	Partitions       []int32       // Partitions to consume, all of the topic's partitions if empty
+	PartitionRefresh time.Duration // How often to look for partitions added to the topic, DefaultPartitionRefresh if zero
+
+	Start   string          // StartNewest or StartOldest, StartNewest if empty
+	Since   time.Time       // Start at the first message produced at or after this time, if set
+	LastN   int64           // Start this many messages before the end of each partition, if set
+	Offsets map[int32]int64 // Offsets to start partitions at, by partition
+
+	GroupID        string                   // Consumer group to join, the stream's default group if empty
+	Standalone     bool                     // Don't join the stream's default group
+	CommitMode     string                   // CommitAuto or CommitManual, CommitAuto if empty
+	CommitInterval time.Duration            // How often CommitAuto commits offsets, every second if zero
+	OnAssign       func(partitions []int32) // Called with the partitions a group rebalance assigned
+	OnRevoke       func(partitions []int32) // Called with the partitions a group rebalance is taking away
+	OnError        func(err error)          // Called with errors instead of delivering them on Subscription.Errors
+}
+
+// validate checks that the options select a single start position
+func (o StreamOptions) validate() error {
+	set := 0
+	switch o.Start {
+	case "", StartNewest:
+	case StartOldest:
+		set++
+	default:
+		return fmt.Errorf("invalid start position %q, expected %s or %s", o.Start, StartNewest, StartOldest)
+	}
+	if !o.Since.IsZero() {
+		set++
+	}
+	if o.LastN < 0 {
+		return fmt.Errorf("invalid number of last messages: %d", o.LastN)
+	}
+	if o.LastN > 0 {
+		set++
+	}
+	if set > 1 {
+		return fmt.Errorf("only one of a start position, a start time or a number of last messages can be given")
+	}
+	for partition, offset := range o.Offsets {
+		if offset < 0 {
+			return fmt.Errorf("invalid offset %d for partition %d", offset, partition)
+		}
+	}
+	switch o.CommitMode {
+	case "", CommitAuto, CommitManual:
+	default:
+		return fmt.Errorf("invalid commit mode %q, expected %s or %s", o.CommitMode, CommitAuto, CommitManual)
+	}
+	if o.GroupID != "" && o.positioned() {
+		return fmt.Errorf("consumer groups resume from committed offsets and can't select partitions or start offsets")
+	}
+	return nil
+}
+
+// positioned reports whether the options select partitions or where they start, which
+// consumer groups decide themselves
+func (o StreamOptions) positioned() bool {
+	return len(o.Partitions) > 0 || len(o.Offsets) > 0 || !o.Since.IsZero() || o.LastN > 0
+}
+
+// Shareable reports whether the options only consume the messages produced from now on,
+// without a consumer group, so that consumers of the same partitions receive the same messages
+// whenever they started
+func (o StreamOptions) Shareable(es *pb.EventStream) bool {
+	return groupID(es, o) == "" && (o.Start == "" || o.Start == StartNewest) && len(o.Offsets) == 0 &&
+		o.Since.IsZero() && o.LastN == 0
+}
+
+// ParseOffsets parses per-partition start offsets such as "0:120,1:98"
+func ParseOffsets(spec string) (map[int32]int64, error) {
+	offsets := map[int32]int64{}
+	for _, entry := range strings.Split(spec, ",") {
+		partition, offset, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid partition offset %q, expected <partition>:<offset>", entry)
+		}
+		p, err := strconv.ParseInt(partition, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid partition %q: %v", partition, err)
+		}
+		o, err := strconv.ParseInt(offset, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset %q: %v", offset, err)
+		}
+		offsets[int32(p)] = o
+	}
+	return offsets, nil
+}
+
+// ParseSince parses a start time given either as an RFC 3339 timestamp or as a duration
+// before now, such as "15m" or "2h"
+func ParseSince(value string) (time.Time, error) {
+	if since, err := time.Parse(time.RFC3339, value); err == nil {
+		return since, nil
+	}
+	ago, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid start time %q, expected an RFC 3339 timestamp or a duration", value)
+	}
+	return time.Now().Add(-ago), nil
+}
+
+// subscribePartitions consumes the selected partitions of a Kafka topic into sink without a
+// consumer group
+func subscribePartitions(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error) {
+	log := logger.GetLogger()
+	log.Debug("Creating Kafka consumer", "brokers", StreamServers(es), "topic", es.Topic)
+	config, err := kafkaConfig(es)
+	if err != nil {
+		return nil, err
+	}
+	config.Consumer.Return.Errors = true
+
+	client, err := sarama.NewClient(StreamServers(es), config)
+	if err != nil {
+		log.Error("Failed to create Kafka consumer", "error", err)
+		return nil, fmt.Errorf("failed to create Kafka consumer: %v", err)
+	}
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		client.Close()
+		log.Error("Failed to create Kafka consumer", "error", err)
+		return nil, fmt.Errorf("failed to create Kafka consumer: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream := &partitionStream{
+		ctx:      ctx,
+		topic:    es.Topic,
+		client:   client,
+		consumer: consumer,
+		sink:     sink,
+		started:  map[int32]bool{},
+	}
+	partitions, err := stream.partitions(options.Partitions)
+	if err == nil {
+		for _, partition := range partitions {
+			var offset int64
+			if offset, err = stream.startOffset(partition, options); err != nil {
+				break
+			}
+			if err = stream.consume(partition, offset); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		cancel()
+		stream.wg.Wait()
+		consumer.Close()
+		client.Close()
+		log.Error("Failed to create partition consumer", "error", err)
+		return nil, fmt.Errorf("failed to create partition consumer: %v", err)
+	}
+
+	if len(options.Partitions) == 0 {
+		refresh := options.PartitionRefresh
+		if refresh <= 0 {
+			refresh = DefaultPartitionRefresh
+		}
+		stream.wg.Add(1)
+		go stream.watchPartitions(refresh)
+	}
+	done := make(doneConsumer)
+	go func() {
+		<-ctx.Done()
+		stream.wg.Wait()
+		log.Debug("Closing consumer", "topic", es.Topic)
+		consumer.Close()
+		client.Close()
+		cancel()
+		close(done)
+	}()
+
+	log.Debug("Event stream consumer started", "brokers", StreamServers(es), "topic", es.Topic, "partitions", partitions)
+	return done, nil
+}
+
+// partitionStream merges the messages of several partitions of a topic into a sink
+type partitionStream struct {
+	ctx      context.Context
+	topic    string
+	client   sarama.Client
+	consumer sarama.Consumer
+	sink     MessageSink
+	wg       sync.WaitGroup
+
+	mu      sync.Mutex
+	started map[int32]bool
+}
+
+// partitions returns the selected partitions after checking the topic has them, or all of the
+// topic's partitions if none are selected
+func (s *partitionStream) partitions(selected []int32) ([]int32, error) {
+	available, err := s.client.Partitions(s.topic)
+	if err != nil {
+		return nil, err
+	}
+	if len(selected) == 0 {
+		return available, nil
+	}
+	exists := map[int32]bool{}
+	for _, partition := range available {
+		exists[partition] = true
+	}
+	for _, partition := range selected {
+		if !exists[partition] {
+			return nil, fmt.Errorf("topic %s has no partition %d", s.topic, partition)
+		}
+	}
+	return selected, nil
+}
+
+// startOffset resolves the offset a partition is first consumed from
+func (s *partitionStream) startOffset(partition int32, options StreamOptions) (int64, error) {
+	if offset, ok := options.Offsets[partition]; ok {
+		return offset, nil
+	}
+	switch {
+	case !options.Since.IsZero():
+		// Kafka answers with the newest offset if no message is that recent
+		return s.client.GetOffset(s.topic, partition, options.Since.UnixMilli())
+	case options.LastN > 0:
+		newest, err := s.client.GetOffset(s.topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return 0, err
+		}
+		oldest, err := s.client.GetOffset(s.topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return 0, err
+		}
+		return max(oldest, newest-options.LastN), nil
+	case options.Start == StartOldest:
+		return sarama.OffsetOldest, nil
+	default:
+		return sarama.OffsetNewest, nil
+	}
+}
+
+// consume starts forwarding the messages of a partition from an offset, unless it is already
+// being consumed
+func (s *partitionStream) consume(partition int32, offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started[partition] {
+		return nil
+	}
+
+	partitionConsumer, err := s.consumer.ConsumePartition(s.topic, partition, offset)
+	if err != nil {
+		return err
+	}
+	s.started[partition] = true
+	s.wg.Add(1)
+	go s.forward(partition, partitionConsumer)
+	return nil
+}
+
+// forward delivers the messages of a partition in order until the subscription ends
+func (s *partitionStream) forward(partition int32, partitionConsumer sarama.PartitionConsumer) {
+	log := logger.GetLogger()
+	defer s.wg.Done()
+	defer partitionConsumer.Close()
+
+	errors := partitionConsumer.Errors()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case msg, ok := <-partitionConsumer.Messages():
+			if !ok {
+				return
+			}
+			log.Debug("Message received", "partition", partition, "offset", msg.Offset, "message", msg.Value)
+			if !s.sink.Deliver(s.ctx, kafkaMessage(msg)) {
+				return
+			}
+		case err, ok := <-errors:
+			if !ok {
+				errors = nil
+				continue
+			}
+			log.Error("Error consuming message", "partition", partition, "error", err)
+			s.sink.Error(err)
+		}
+	}
+}
+
+// watchPartitions periodically refreshes the topic's metadata and consumes partitions added
+// since the stream started
+func (s *partitionStream) watchPartitions(interval time.Duration) {
+	log := logger.GetLogger()
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.client.RefreshMetadata(s.topic); err != nil {
+			log.Warn("Failed to refresh topic metadata", "topic", s.topic, "error", err)
+			s.sink.Error(err)
+			continue
+		}
+		partitions, err := s.client.Partitions(s.topic)
+		if err != nil {
+			log.Warn("Failed to list topic partitions", "topic", s.topic, "error", err)
+			continue
+		}
+		for _, partition := range partitions {
+			s.mu.Lock()
+			started := s.started[partition]
+			s.mu.Unlock()
+			if started {
+				continue
+			}
+			// Start from the beginning, so that messages sent before the partition was noticed aren't lost
+			log.Info("Consuming new partition", "topic", s.topic, "partition", partition)
+			if err := s.consume(partition, sarama.OffsetOldest); err != nil {
+				log.Error("Failed to create partition consumer", "partition", partition, "error", err)
+				s.sink.Error(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+func TestStartOffsets(t *testing.T) {
+	broker := newMockKafka(t, "orders", []string{"a", "b", "c"}, []string{"d"})
+	es := &pb.EventStream{Brokers: []string{broker.Addr()}, Topic: "orders"}
+
+	starts := []struct {
+		options StreamOptions
+		want    []string
+	}{
+		{StreamOptions{LastN: 1}, []string{"0/2/c", "1/0/d"}},
+		// Offsets override the start position for the partitions they list
+		{StreamOptions{LastN: 5, Offsets: map[int32]int64{0: 1}}, []string{"0/1/b", "0/2/c", "1/0/d"}},
+		{StreamOptions{Partitions: []int32{0}, Start: StartOldest}, []string{"0/0/a", "0/1/b", "0/2/c"}},
+	}
+	for _, start := range starts {
+		subscription, err := SubscribeEventStream(context.Background(), es, start.options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := received(receive(t, subscription, len(start.want)))
+		subscription.Close()
+		if fmt.Sprint(got) != fmt.Sprint(start.want) {
+			t.Errorf("options %+v consumed %v, want %v", start.options, got, start.want)
+		}
+	}
+}
+
+func TestStreamOptionsValidate(t *testing.T) {
+	invalid := []StreamOptions{
+		{Start: "latest"},
+		{Start: StartOldest, LastN: 10},
+		{Since: time.Now(), LastN: 10},
+		{LastN: -1},
+		{Offsets: map[int32]int64{0: -5}},
+		{CommitMode: "sometimes"},
+		{GroupID: "billing", Partitions: []int32{0}},
+	}
+	for _, options := range invalid {
+		if err := options.validate(); err == nil {
+			t.Errorf("options %+v accepted", options)
+		}
+	}
+	if err := (StreamOptions{Start: StartOldest, Offsets: map[int32]int64{1: 20}}).validate(); err != nil {
+		t.Errorf("offsets with a start position for the other partitions rejected: %v", err)
+	}
+}
+
+func TestParseOffsets(t *testing.T) {
+	offsets, err := ParseOffsets("0:120, 1:98")
+	if err != nil || len(offsets) != 2 || offsets[0] != 120 || offsets[1] != 98 {
+		t.Errorf("ParseOffsets = %v, %v", offsets, err)
+	}
+	for _, spec := range []string{"0", "a:1", "0:b", "0:1,"} {
+		if _, err := ParseOffsets(spec); err == nil {
+			t.Errorf("ParseOffsets(%q) succeeded", spec)
+		}
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	since, err := ParseSince("2024-05-01T12:00:00Z")
+	if err != nil || !since.Equal(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("ParseSince of a timestamp = %v, %v", since, err)
+	}
+	since, err = ParseSince("15m")
+	if ago := time.Since(since); err != nil || ago < 15*time.Minute || ago > 16*time.Minute {
+		t.Errorf("ParseSince(15m) is %v ago, %v", ago, err)
+	}
+	if _, err := ParseSince("yesterday"); err == nil {
+		t.Error("ParseSince(yesterday) succeeded")
+	}
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"nexus/pkg/logger"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// subscriptionErrorBuffer is the number of errors a subscription holds for its caller before
+// dropping new ones
+const subscriptionErrorBuffer = 16
+
+// Message is a message consumed from an event stream
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Timestamp time.Time
+}
+
+// Header is a key and value attached to a message, keys may repeat
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Subscription delivers the messages of an event stream until it is closed or its context is
+// done. Errors that don't end the subscription, such as a partition failing to fetch, are
+// delivered separately.
+type Subscription struct {
+	messages chan *Message
+	errors   chan error
+	onError  func(error)
+	cancel   context.CancelFunc
+	done     chan struct{}
+	commit   func() error
+}
+
+// SubscribeEventStream subscribes to an event stream through the driver of its transport.
+// Without a consumer group, the messages of the selected partitions are merged, each
+// partition's in order, and partitions added to the topic later are consumed from their first
+// message. If the options or the stream name a consumer group, the subscription joins it
+// instead, see JoinGroup. Transports that don't retain messages or have no partitions reject
+// options they can't honour.
+func SubscribeEventStream(ctx context.Context, es *pb.EventStream, options StreamOptions) (*Subscription, error) {
+	log := logger.GetLogger()
+	if es == nil {
+		log.Error("No event stream provided")
+		return nil, fmt.Errorf("no event stream provided")
+	}
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+	driver, err := GetTransport(es.Transport)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, ctx := newSubscription(ctx, options)
+	consumer, err := driver.Subscribe(ctx, es, options, subscriptionSink{subscription})
+	if err != nil {
+		subscription.cancel()
+		return nil, err
+	}
+	subscription.commit = consumer.Commit
+	go func() {
+		<-consumer.Done()
+		subscription.finish()
+	}()
+	return subscription, nil
+}
+
+// GetEventStream subscribes to an event stream and processes events in real-time
+func GetEventStream(es *pb.EventStream) (<-chan []byte, error) {
+	return GetEventStreamContext(context.Background(), es, StreamOptions{})
+}
+
+// GetEventStreamContext subscribes to an event stream like SubscribeEventStream, delivering
+// only the value of each message. The subscription is closed, and the returned channel with
+// it, once ctx is done. Manual commits need the Subscription itself.
+func GetEventStreamContext(ctx context.Context, es *pb.EventStream, options StreamOptions) (<-chan []byte, error) {
+	if options.CommitMode == CommitManual {
+		return nil, fmt.Errorf("manual commits need a subscription from SubscribeEventStream")
+	}
+	subscription, err := SubscribeEventStream(ctx, es, options)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(chan []byte)
+	go func() {
+		defer close(values)
+		for message := range subscription.Messages() {
+			select {
+			case values <- message.Value:
+			case <-ctx.Done():
+				subscription.Close()
+				return
+			}
+		}
+	}()
+	return values, nil
+}
+
+// newSubscription creates a subscription ending when ctx is done or it is closed, and returns
+// the context its consumers run under
+func newSubscription(ctx context.Context, options StreamOptions) (*Subscription, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Subscription{
+		messages: make(chan *Message),
+		errors:   make(chan error, subscriptionErrorBuffer),
+		onError:  options.OnError,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}, ctx
+}
+
+// Messages returns the channel messages are delivered on, closed when the subscription ends
+func (s *Subscription) Messages() <-chan *Message {
+	return s.messages
+}
+
+// Errors returns the channel errors are delivered on unless StreamOptions.OnError is set,
+// closed when the subscription ends. Errors arriving while the channel is full are dropped.
+func (s *Subscription) Errors() <-chan error {
+	return s.errors
+}
+
+// Commit commits the offsets of every message delivered so far, for subscriptions joining a
+// consumer group. With CommitManual, this is the only way offsets are committed.
+func (s *Subscription) Commit() error {
+	if s.commit == nil {
+		return fmt.Errorf("only consumer group subscriptions commit offsets")
+	}
+	return s.commit()
+}
+
+// Close ends the subscription and waits for its consumers to be released
+func (s *Subscription) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// send passes a message to the subscriber, reporting false if the subscription ended first
+func (s *Subscription) send(ctx context.Context, message *Message) bool {
+	select {
+	case s.messages <- message:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// reportError passes an error to the subscriber's callback or error channel
+func (s *Subscription) reportError(err error) {
+	if s.onError != nil {
+		s.onError(err)
+		return
+	}
+	select {
+	case s.errors <- err:
+	default:
+		logger.GetLogger().Warn("Dropped subscription error", "error", err)
+	}
+}
+
+// finish closes the subscription's channels once its consumers have stopped
+func (s *Subscription) finish() {
+	s.cancel()
+	close(s.messages)
+	close(s.errors)
+	close(s.done)
+}
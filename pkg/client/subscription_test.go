@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// TestSubscriptionEnds checks that a subscription's channels close once its context is done or
+// it is closed, so ranging over them ends
+func TestSubscriptionEnds(t *testing.T) {
+	es := &pb.EventStream{Transport: TransportInProcess, Server: t.Name(), Topic: "orders"}
+	appendInProcess(es, "a")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	subscription, err := SubscribeEventStream(ctx, es, StreamOptions{Start: StartOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(t, subscription, 1)
+	cancel()
+	select {
+	case _, open := <-subscription.Messages():
+		if open {
+			t.Error("message delivered after the context ended")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("messages channel still open after the context ended")
+	}
+	if _, open := <-subscription.Errors(); open {
+		t.Error("errors channel still open after the context ended")
+	}
+	// Closing an ended subscription doesn't block
+	subscription.Close()
+
+	values, err := GetEventStreamContext(context.Background(), es, StreamOptions{Start: StartOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value := <-values; string(value) != "a" {
+		t.Errorf("value = %s, want a", value)
+	}
+}
+
+func TestSubscriptionErrors(t *testing.T) {
+	subscription, _ := newSubscription(context.Background(), StreamOptions{})
+	failure := errors.New("partition unavailable")
+	// Errors beyond the buffer are dropped rather than holding up the consumer
+	for i := 0; i < subscriptionErrorBuffer+5; i++ {
+		subscription.reportError(failure)
+	}
+	subscription.finish()
+	var n int
+	for err := range subscription.Errors() {
+		if err != failure {
+			t.Errorf("error = %v, want %v", err, failure)
+		}
+		n++
+	}
+	if n != subscriptionErrorBuffer {
+		t.Errorf("%d errors delivered, want %d", n, subscriptionErrorBuffer)
+	}
+
+	var reported []error
+	subscription, _ = newSubscription(context.Background(), StreamOptions{OnError: func(err error) { reported = append(reported, err) }})
+	subscription.reportError(failure)
+	subscription.finish()
+	if len(reported) != 1 {
+		t.Errorf("OnError called with %v, want the error", reported)
+	}
+	if _, open := <-subscription.Errors(); open {
+		t.Error("error delivered on the channel as well as to OnError")
+	}
+}
+
+func TestGetEventStreamManualCommit(t *testing.T) {
+	es := &pb.EventStream{Transport: TransportInProcess, Server: t.Name(), Topic: "orders"}
+	if _, err := GetEventStreamContext(context.Background(), es, StreamOptions{GroupID: "billing", CommitMode: CommitManual}); err == nil {
+		t.Error("value channel accepted manual commits, which it can't make")
+	}
+	subscription, err := SubscribeEventStream(context.Background(), es, StreamOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Close()
+	if err := subscription.Commit(); err == nil {
+		t.Error("subscription without a group committed")
+	}
+}
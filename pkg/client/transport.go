@@ -0,0 +1,264 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	pb "nexus/pkg/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Transports carrying event streams, set as EventStream.Transport
+const (
+	TransportKafka     = "kafka"
+	TransportNATS      = "nats"
+	TransportMQTT      = "mqtt"
+	TransportRedis     = "redis"
+	TransportInProcess = "inproc" // A broker inside the current process, for local testing
+)
+
+// MessageSink receives the messages a transport consumes for a subscription
+type MessageSink interface {
+	// Deliver passes a message to the subscriber, reporting false once the subscription ended
+	Deliver(ctx context.Context, message *Message) bool
+	// Error reports an error that doesn't end the subscription
+	Error(err error)
+}
+
+// Consumer is a transport's consumption of an event stream for one subscription
+type Consumer interface {
+	// Done is closed once the consumer has stopped delivering, after its context is done, and
+	// released its connections
+	Done() <-chan struct{}
+	// Commit commits the position of the messages delivered so far, for consumers in a group
+	Commit() error
+}
+
+// TransportProducer sends messages to the event streams of one set of servers
+type TransportProducer interface {
+	// Send queues a message for an event stream. Unless it fails, report is called once with the
+	// message's delivery.
+	Send(es *pb.EventStream, message *Message, report func(Delivery)) error
+	// Close delivers the messages queued and releases the producer's connections
+	Close() error
+}
+
+// TransportDriver connects event streams to the messaging system carrying them
+type TransportDriver interface {
+	// Subscribe starts consuming an event stream into sink until ctx is done
+	Subscribe(ctx context.Context, es *pb.EventStream, options StreamOptions, sink MessageSink) (Consumer, error)
+	// NewProducer creates a producer for the servers of an event stream
+	NewProducer(es *pb.EventStream, options ProducerOptions) (TransportProducer, error)
+	// Check reports why an event stream can't be consumed, if it can't
+	Check(ctx context.Context, es *pb.EventStream) error
+}
+
+var (
+	transportsMu sync.RWMutex
+	transports   = map[string]TransportDriver{}
+)
+
+// RegisterTransport registers the driver of a transport, replacing any driver previously
+// registered for it
+func RegisterTransport(name string, driver TransportDriver) {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	transports[strings.ToLower(name)] = driver
+}
+
+// GetTransport returns the driver registered for a transport, Kafka's if name is empty
+func GetTransport(name string) (TransportDriver, error) {
+	if name == "" {
+		name = TransportKafka
+	}
+	transportsMu.RLock()
+	defer transportsMu.RUnlock()
+	driver, ok := transports[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("no driver registered for transport: %s", name)
+	}
+	return driver, nil
+}
+
+func init() {
+	RegisterTransport(TransportKafka, kafkaDriver{})
+	RegisterTransport(TransportNATS, natsDriver{})
+	RegisterTransport(TransportMQTT, mqttDriver{})
+	RegisterTransport(TransportRedis, redisDriver{})
+	RegisterTransport(TransportInProcess, inProcessDriver{})
+}
+
+// subscriptionSink delivers the messages of a consumer to a subscription
+type subscriptionSink struct {
+	subscription *Subscription
+}
+
+func (s subscriptionSink) Deliver(ctx context.Context, message *Message) bool {
+	return s.subscription.send(ctx, message)
+}
+
+func (s subscriptionSink) Error(err error) {
+	s.subscription.reportError(err)
+}
+
+// gatedSink stops passing messages to a sink once closed, for client libraries that deliver
+// messages from their own goroutines, which may still be running when the consumer stops
+type gatedSink struct {
+	sink MessageSink
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func (g *gatedSink) Deliver(ctx context.Context, message *Message) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return !g.closed && g.sink.Deliver(ctx, message)
+}
+
+func (g *gatedSink) Error(err error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if !g.closed {
+		g.sink.Error(err)
+	}
+}
+
+// close waits for deliveries in progress, which end once the subscription's context is done
+func (g *gatedSink) close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closed = true
+}
+
+// doneConsumer is a Consumer without commits, done once the channel is closed
+type doneConsumer chan struct{}
+
+func (c doneConsumer) Done() <-chan struct{} {
+	return c
+}
+
+func (c doneConsumer) Commit() error {
+	return fmt.Errorf("only consumer group subscriptions commit offsets")
+}
+
+// StreamServers returns the server addresses of an event stream, falling back to the single
+// server of streams registered before server lists
+func StreamServers(es *pb.EventStream) []string {
+	if len(es.Brokers) > 0 {
+		return es.Brokers
+	}
+	if es.Server != "" {
+		return []string{es.Server}
+	}
+	return nil
+}
+
+// streamTLSConfig loads the CAs and client certificate of a stream's TLS settings
+func streamTLSConfig(settings *pb.KafkaTLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         settings.ServerName,
+		InsecureSkipVerify: settings.InsecureSkipVerify,
+	}
+	if settings.CaFile != "" {
+		pem, err := os.ReadFile(settings.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file: %s", settings.CaFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if settings.CertFile != "" || settings.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(settings.CertFile, settings.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// streamCluster identifies the transport and connection settings of an event stream, which
+// streams on the same servers share
+func streamCluster(es *pb.EventStream) string {
+	settings := proto.Clone(es).(*pb.EventStream)
+	settings.Topic = ""
+	settings.DefaultGroupId = ""
+	settings.Brokers = StreamServers(es)
+	settings.Server = ""
+	key, _ := proto.MarshalOptions{Deterministic: true}.Marshal(settings)
+	return string(key)
+}
+
+// streamCredential resolves the credential an event stream authenticates with, if any
+func streamCredential(es *pb.EventStream) (*Credential, error) {
+	if es.Sasl == nil {
+		return nil, nil
+	}
+	if es.Sasl.CredentialRef == "" {
+		return nil, fmt.Errorf("SASL needs a credential reference")
+	}
+	credential, err := LookupCredential(es.Sasl.CredentialRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve credentials: %v", err)
+	}
+	return credential, nil
+}
+
+// noManualCommit rejects CommitManual for consumer groups of a transport that acknowledges
+// messages as they are delivered
+func noManualCommit(transport string, options StreamOptions) error {
+	if options.CommitMode == CommitManual {
+		return fmt.Errorf("%s consumer groups can't be committed manually", transport)
+	}
+	return nil
+}
+
+// consumerName returns the stream's client ID, or else a name unique to this process
+func consumerName(es *pb.EventStream) string {
+	if es.ClientId != "" {
+		return es.ClientId
+	}
+	return uniqueName("nexus")
+}
+
+// uniqueName appends a random suffix to a client name
+func uniqueName(prefix string) string {
+	suffix := make([]byte, 6)
+	rand.Read(suffix)
+	return prefix + "-" + hex.EncodeToString(suffix)
+}
+
+// onlyTail rejects options a transport without retained messages can't honour
+func onlyTail(transport string, options StreamOptions) error {
+	if options.Start == StartOldest || !options.Since.IsZero() || options.LastN > 0 || len(options.Offsets) > 0 {
+		return fmt.Errorf("%s only delivers messages published after subscribing", transport)
+	}
+	return onlyPartitionZero(transport, options)
+}
+
+// onlyPartitionZero rejects options selecting partitions of a transport without them
+func onlyPartitionZero(transport string, options StreamOptions) error {
+	for _, partition := range options.Partitions {
+		if partition != 0 {
+			return fmt.Errorf("%s streams only have partition 0", transport)
+		}
+	}
+	for partition := range options.Offsets {
+		if partition != 0 {
+			return fmt.Errorf("%s streams only have partition 0", transport)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultWatchDebounce is how long a watcher waits for changes to settle before publishing them
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// DirectoryWatch maps a local directory to the trie prefix its files are registered under
+type DirectoryWatch struct {
+	Dir           string `json:"dir"`            // Local directory to watch, including its subdirectories
+	Prefix        string `json:"prefix"`         // Trie path files are registered under, followed by their path relative to Dir
+	DirectoryPath string `json:"directory_path"` // Trie path of a Directory node kept current for Dir, none if empty
+}
+
+// Watcher keeps the files of local directories registered with a Nexus server. New files are
+// registered, removed files deregistered and Directory nodes refreshed as the files change.
+// Hidden files and directories are ignored.
+type Watcher struct {
+	Infer    bool                             // Infer the schema of files and directories when registering them
+	Debounce time.Duration                    // Quiet period after a change before it is published
+	OnChange func(action string, path string) // Called with "registered", "deregistered" or "refreshed" and a trie path, if set
+
+	client     *NexusClient
+	watches    []DirectoryWatch
+	fs         *fsnotify.Watcher
+	registered map[string]string // Trie path each local file is registered under
+}
+
+// NewWatcher creates a watcher publishing the files of the given directories
+func NewWatcher(client *NexusClient, watches ...DirectoryWatch) (*Watcher, error) {
+	if len(watches) == 0 {
+		return nil, fmt.Errorf("no directories to watch")
+	}
+	for i := range watches {
+		if watches[i].Prefix == "" {
+			return nil, fmt.Errorf("no trie prefix given for directory: %s", watches[i].Dir)
+		}
+		dir, err := filepath.Abs(watches[i].Dir)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("not a directory: %s", dir)
+		}
+		watches[i].Dir = dir
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &Watcher{
+		Debounce:   DefaultWatchDebounce,
+		client:     client,
+		watches:    watches,
+		fs:         fsWatcher,
+		registered: map[string]string{},
+	}, nil
+}
+
+// Run deregisters files removed since the directories were last watched and registers their
+// current files, then publishes changes as they happen until ctx is done
+func (w *Watcher) Run(ctx context.Context) error {
+	log := logger.GetLogger()
+	defer w.fs.Close()
+
+	changed := map[string]bool{}
+	for _, watch := range w.watches {
+		log.Info("Watching directory", "dir", watch.Dir, "prefix", watch.Prefix)
+		if err := w.removeStale(watch, watch.Prefix); err != nil {
+			return err
+		}
+		changed[watch.Dir] = true
+	}
+	w.sync(changed)
+
+	pending := map[string]bool{}
+	timer := time.NewTimer(w.Debounce)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.fs.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			log.Debug("File changed", "path", event.Name, "op", event.Op)
+			pending[event.Name] = true
+			timer.Reset(w.Debounce)
+		case err, ok := <-w.fs.Errors:
+			if !ok {
+				return nil
+			}
+			log.Error("Failed to watch directory", "error", err)
+		case <-timer.C:
+			w.sync(pending)
+			pending = map[string]bool{}
+		}
+	}
+}
+
+// sync publishes the current state of changed local paths and refreshes the Directory nodes of
+// the watches they belong to
+func (w *Watcher) sync(paths map[string]bool) {
+	refresh := map[int]bool{}
+	for path := range paths {
+		i := w.watchOf(path)
+		if i < 0 || isHidden(w.watches[i].Dir, path) {
+			continue
+		}
+		refresh[i] = true
+
+		info, err := os.Stat(path)
+		switch {
+		case err != nil:
+			// Removed or renamed away, taking any files below it along
+			w.deregister(i, path)
+		case info.IsDir():
+			w.scan(i, path)
+		default:
+			w.register(i, path)
+		}
+	}
+	for i := range refresh {
+		w.refreshDirectory(w.watches[i])
+	}
+}
+
+// scan watches a directory and its subdirectories and registers the files in them
+func (w *Watcher) scan(i int, dir string) {
+	log := logger.GetLogger()
+	filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			log.Error("Failed to read directory", "path", path, "error", err)
+			return nil
+		}
+		if isHidden(w.watches[i].Dir, path) {
+			return skipEntry(entry)
+		}
+		if entry.IsDir() {
+			if err := w.fs.Add(path); err != nil {
+				log.Error("Failed to watch directory", "path", path, "error", err)
+			}
+			return nil
+		}
+		w.register(i, path)
+		return nil
+	})
+}
+
+// register publishes a local file under its watch's prefix, refreshing it if already registered
+func (w *Watcher) register(i int, path string) {
+	log := logger.GetLogger()
+	triePath := w.triePath(w.watches[i], path)
+
+	file := CreateIndividualFile(path)
+	if w.Infer {
+		if err := InferIndividualFile(file); err != nil {
+			log.Warn("Failed to infer file schema", "path", path, "error", err)
+		}
+	}
+	if err := w.client.PublishIndividualFile(triePath, file); err != nil {
+		log.Error("Failed to register file", "path", path, "error", err)
+		return
+	}
+	w.registered[path] = triePath
+	w.notify("registered", triePath)
+}
+
+// deregister removes the registrations of a local file, or of every file below a directory
+// along with the directory's trie node
+func (w *Watcher) deregister(i int, path string) {
+	log := logger.GetLogger()
+	if _, isFile := w.registered[path]; !isFile && path != w.watches[i].Dir {
+		defer func() {
+			if err := w.client.Delete(w.triePath(w.watches[i], path)); err != nil {
+				log.Debug("No trie node for removed directory", "path", path, "error", err)
+			}
+		}()
+	}
+	for file, triePath := range w.registered {
+		if file != path && !strings.HasPrefix(file, path+string(filepath.Separator)) {
+			continue
+		}
+		dependents, err := w.client.DeleteWithDependents(triePath)
+		if err != nil {
+			log.Error("Failed to deregister file", "path", file, "error", err)
+			continue
+		}
+		delete(w.registered, file)
+		w.notify("deregistered", triePath)
+		warnDependents(triePath, dependents)
+	}
+}
+
+// removeStale deregisters the files registered below a trie path that belong to a watched
+// directory but no longer exist
+func (w *Watcher) removeStale(watch DirectoryWatch, path string) error {
+	log := logger.GetLogger()
+	children, err := w.client.GetChildren(path)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		childPath := strings.TrimRight(path, "/") + "/" + child.Name
+		if child.NumChildren > 0 {
+			if err := w.removeStale(watch, childPath); err != nil {
+				return err
+			}
+		}
+		if child.Type != "IndividualFile" {
+			continue
+		}
+
+		value, _, err := w.client.GetFull(childPath)
+		if err != nil {
+			return err
+		}
+		file, ok := value.(*pb.IndividualFile)
+		if !ok || !isWithin(watch.Dir, file.FilePath) {
+			continue
+		}
+		if _, err := os.Stat(file.FilePath); errors.Is(err, os.ErrNotExist) {
+			log.Debug("Registered file no longer exists", "path", file.FilePath)
+			dependents, err := w.client.DeleteWithDependents(childPath)
+			if err != nil {
+				return err
+			}
+			w.notify("deregistered", childPath)
+			warnDependents(childPath, dependents)
+		}
+	}
+	return nil
+}
+
+// refreshDirectory republishes the Directory node of a watch with its current file count and
+// modification time
+func (w *Watcher) refreshDirectory(watch DirectoryWatch) {
+	log := logger.GetLogger()
+	if watch.DirectoryPath == "" {
+		return
+	}
+
+	directory, err := CreateDirectory(watch.Dir)
+	if err != nil {
+		log.Error("Failed to refresh directory", "path", watch.Dir, "error", err)
+		return
+	}
+	if w.Infer {
+		if err := InferDirectory(directory); err != nil {
+			log.Warn("Failed to infer directory schema", "path", watch.Dir, "error", err)
+		}
+	}
+	if err := w.client.PublishDirectory(watch.DirectoryPath, directory); err != nil {
+		log.Error("Failed to refresh directory", "path", watch.Dir, "error", err)
+		return
+	}
+	w.notify("refreshed", watch.DirectoryPath)
+}
+
+func (w *Watcher) notify(action string, path string) {
+	log := logger.GetLogger()
+	log.Info("Watched path changed", "action", action, "path", path)
+	if w.OnChange != nil {
+		w.OnChange(action, path)
+	}
+}
+
+// warnDependents logs the paths derived from a deregistered file, which are now broken
+func warnDependents(path string, dependents []string) {
+	log := logger.GetLogger()
+	if len(dependents) > 0 {
+		log.Warn("Deregistered file has dependents", "path", path, "dependents", dependents)
+	}
+}
+
+// watchOf returns the index of the innermost watched directory containing a path, or -1
+func (w *Watcher) watchOf(path string) int {
+	found := -1
+	for i, watch := range w.watches {
+		if isWithin(watch.Dir, path) && (found < 0 || len(watch.Dir) > len(w.watches[found].Dir)) {
+			found = i
+		}
+	}
+	return found
+}
+
+// triePath maps a local file to the trie path it is registered under
+func (w *Watcher) triePath(watch DirectoryWatch, path string) string {
+	rel, err := filepath.Rel(watch.Dir, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+	return strings.TrimRight(watch.Prefix, "/") + "/" + filepath.ToSlash(rel)
+}
+
+// isWithin reports whether a path is dir or lies below it
+func isWithin(dir string, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+// isHidden reports whether a path below dir is, or lies within, a hidden file or directory
+func isHidden(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." {
+		return false
+	}
+	for _, name := range strings.Split(filepath.ToSlash(rel), "/") {
+		if isHiddenName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHiddenName reports whether a file or directory name starts with a dot, like editor swap
+// files and .DS_Store
+func isHiddenName(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+// skipEntry skips an entry of a directory walk, along with its contents if it's a directory
+func skipEntry(entry fs.DirEntry) error {
+	if entry.IsDir() {
+		return filepath.SkipDir
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// RowSink receives the rows of a dataset being written to a file
+type RowSink interface {
+	Write(row []interface{}) error
+	// Close flushes the rows written and closes the file
+	Close() error
+}
+
+// DatasetWriter creates data files of a particular format from streams of typed rows
+type DatasetWriter interface {
+	Create(filePath string, columns []Column) (RowSink, error)
+}
+
+var (
+	writersMu sync.RWMutex
+	writers   = map[string]DatasetWriter{}
+)
+
+// RegisterWriter registers a writer for a file type such as "csv" or ".csv",
+// replacing any writer previously registered for it
+func RegisterWriter(fileType string, writer DatasetWriter) {
+	writersMu.Lock()
+	defer writersMu.Unlock()
+	writers[normalizeFileType(fileType)] = writer
+}
+
+// GetWriter returns the writer registered for a file type
+func GetWriter(fileType string) (DatasetWriter, error) {
+	writersMu.RLock()
+	defer writersMu.RUnlock()
+	writer, ok := writers[normalizeFileType(fileType)]
+	if !ok {
+		return nil, fmt.Errorf("no writer registered for file type: %s", fileType)
+	}
+	return writer, nil
+}
+
+// WriteCursor writes the rows of a cursor to a data file with the writer registered for its
+// file type and returns the number of rows written. The cursor is closed. Rows are written to
+// a hidden file in the same directory that replaces the file once complete, so a file already
+// there is left as it was if writing fails part way.
+func WriteCursor(cursor *Cursor, filePath string, fileType string) (int64, error) {
+	log := logger.GetLogger()
+	log.Debug("Writing table", "path", filePath, "type", fileType)
+	defer cursor.Close()
+
+	writer, err := GetWriter(fileType)
+	if err != nil {
+		return 0, err
+	}
+	tempPath, mode, err := createTempFile(filePath)
+	if err != nil {
+		log.Error("Failed to create file", "path", filePath, "error", err)
+		return 0, err
+	}
+	defer os.Remove(tempPath) // Gone already once renamed
+	sink, err := writer.Create(tempPath, cursor.Columns())
+	if err != nil {
+		log.Error("Failed to create file", "path", filePath, "error", err)
+		return 0, err
+	}
+
+	var count int64
+	for {
+		batch, err := cursor.Next()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			for _, row := range batch {
+				if err = sink.Write(row); err != nil {
+					break
+				}
+				count++
+			}
+		}
+		if err != nil {
+			sink.Close()
+			log.Error("Failed to write table", "path", filePath, "error", err)
+			return count, err
+		}
+	}
+	if err := sink.Close(); err != nil {
+		log.Error("Failed to write table", "path", filePath, "error", err)
+		return count, err
+	}
+	if err := os.Chmod(tempPath, mode); err != nil {
+		log.Error("Failed to write table", "path", filePath, "error", err)
+		return count, err
+	}
+	if err := os.Rename(tempPath, filePath); err != nil {
+		log.Error("Failed to replace file", "path", filePath, "error", err)
+		return count, err
+	}
+
+	log.Debug("Table written successfully", "path", filePath, "rows", count)
+	return count, nil
+}
+
+// createTempFile creates an empty hidden file next to a file about to be written, so it can be
+// renamed over it, and returns it with the mode the file should have: that of the file it
+// replaces, or 0644 for new files
+func createTempFile(filePath string) (string, os.FileMode, error) {
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(filePath); err == nil {
+		if !info.Mode().IsRegular() {
+			return "", 0, fmt.Errorf("%s isn't a regular file", filePath)
+		}
+		mode = info.Mode().Perm()
+	}
+	file, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return "", 0, err
+	}
+	return file.Name(), mode, file.Close()
+}
+
+// typedValue converts a value to the Go type used for the column type, parsing it from its
+// textual form if it doesn't match. It reports false if the value can't be converted.
+func typedValue(value interface{}, columnType string) (interface{}, bool) {
+	switch value.(type) {
+	case nil:
+		return nil, true
+	case string:
+		if columnType == TypeString {
+			return value, true
+		}
+	case int64:
+		if columnType == TypeInt {
+			return value, true
+		}
+	case float64:
+		if columnType == TypeFloat {
+			return value, true
+		}
+	case bool:
+		if columnType == TypeBool {
+			return value, true
+		}
+	}
+	if columnType == TypeString {
+		return FormatValue(value), true
+	}
+	converted := convertString(FormatValue(value), columnType)
+	_, failed := converted.(string)
+	return converted, !failed
+}
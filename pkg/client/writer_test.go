@@ -0,0 +1,106 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// failingSource returns its rows and then an error, like a connection dropped mid-read
+type failingSource struct {
+	rows [][]interface{}
+}
+
+func (s *failingSource) Columns() []Column {
+	return []Column{{Name: "id", Type: TypeInt}}
+}
+
+func (s *failingSource) Next() ([]interface{}, error) {
+	if len(s.rows) == 0 {
+		return nil, errors.New("connection reset")
+	}
+	row := s.rows[0]
+	s.rows = s.rows[1:]
+	return row, nil
+}
+
+func (s *failingSource) Close() error {
+	return nil
+}
+
+func TestWriteCursorReplacesFile(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "out.csv")
+	if err := os.WriteFile(filePath, []byte("id\n1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	table := &Table{Columns: []Column{{Name: "id", Type: TypeInt}}, Rows: [][]interface{}{{int64(2)}, {int64(3)}}}
+	cursor, err := NewCursor(NewTableSource(table), ReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := WriteCursor(cursor, filePath, "csv")
+	if err != nil || count != 2 {
+		t.Fatalf("wrote %d rows: %v", count, err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "id\n2\n3\n" {
+		t.Errorf("file holds %q after writing", data)
+	}
+	if info, _ := os.Stat(filePath); info.Mode().Perm() != 0o600 {
+		t.Errorf("replaced file has mode %v, want the original 0600", info.Mode().Perm())
+	}
+	assertOnlyFile(t, dir, "out.csv")
+}
+
+// TestWriteCursorFailure checks that a write failing part way leaves the existing file as it
+// was, rather than truncated or removed
+func TestWriteCursorFailure(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "out.csv")
+	if err := os.WriteFile(filePath, []byte("id\n1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cursor, err := NewCursor(&failingSource{rows: [][]interface{}{{int64(2)}}}, ReadOptions{BatchSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := WriteCursor(cursor, filePath, "csv"); err == nil || err == io.EOF {
+		t.Fatalf("write of a failing cursor returned %v", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("existing file is gone: %v", err)
+	}
+	if string(data) != "id\n1\n" {
+		t.Errorf("existing file changed to %q", data)
+	}
+	assertOnlyFile(t, dir, "out.csv")
+}
+
+// assertOnlyFile fails unless a directory only holds the named file, so no temporary file
+// was left behind
+func assertOnlyFile(t *testing.T, dir string, name string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if strings.Join(names, ",") != name {
+		t.Errorf("directory holds %v, want only %s", names, name)
+	}
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// XMLReader reads XML documents in which each child of the root element is a record,
+// with the record's attributes and child elements as its columns. Columns are collected
+// in a first pass over the document, then records are streamed in a second.
+type XMLReader struct{}
+
+func init() {
+	RegisterReader("xml", &XMLReader{})
+}
+
+// xmlElement is a generic XML element used to walk documents of unknown structure
+type xmlElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr   `xml:",any,attr"`
+	Content  string       `xml:",chardata"`
+	Children []xmlElement `xml:",any"`
+}
+
+// Open implements DatasetReader
+func (r *XMLReader) Open(filePath string) (RowSource, error) {
+	source, err := openXMLRecords(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer source.Close()
+
+	header := []string{}
+	types := []string{}
+	index := map[string]int{}
+	count := 0
+	for {
+		record, err := source.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		names, values := xmlFields(record)
+		for i, name := range names {
+			j, seen := index[name]
+			if !seen {
+				j = len(header)
+				index[name] = j
+				header = append(header, name)
+				types = append(types, "")
+			}
+			types[j] = mergeType(types[j], inferStringType(values[i]))
+		}
+		count++
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("no XML records found")
+	}
+
+	records, err := openXMLRecords(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &xmlSource{records: records, columns: stringColumns(header, types), index: index}, nil
+}
+
+// xmlRecords decodes the children of a document's root element one at a time
+type xmlRecords struct {
+	file    *os.File
+	decoder *xml.Decoder
+	inRoot  bool
+}
+
+func openXMLRecords(filePath string) (*xmlRecords, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &xmlRecords{file: file, decoder: xml.NewDecoder(file)}, nil
+}
+
+func (r *xmlRecords) next() (xmlElement, error) {
+	for {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return xmlElement{}, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if !r.inRoot {
+				r.inRoot = true
+				continue
+			}
+			var record xmlElement
+			if err := r.decoder.DecodeElement(&record, &t); err != nil {
+				return xmlElement{}, err
+			}
+			return record, nil
+		case xml.EndElement:
+			// The end of the root element ends the records
+			return xmlElement{}, io.EOF
+		}
+	}
+}
+
+func (r *xmlRecords) Close() error {
+	return r.file.Close()
+}
+
+// xmlSource streams the records of an XML document as rows
+type xmlSource struct {
+	records *xmlRecords
+	columns []Column
+	index   map[string]int
+}
+
+func (s *xmlSource) Columns() []Column {
+	return s.columns
+}
+
+func (s *xmlSource) Next() ([]interface{}, error) {
+	record, err := s.records.next()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(s.columns))
+	names, recordValues := xmlFields(record)
+	for i, name := range names {
+		values[s.index[name]] = recordValues[i]
+	}
+	return stringRow(values, s.columns), nil
+}
+
+func (s *xmlSource) Close() error {
+	return s.records.Close()
+}
+
+// xmlFields returns the names and values of a record's attributes and child elements in document order
+func xmlFields(record xmlElement) ([]string, []string) {
+	var names, values []string
+	for _, attr := range record.Attrs {
+		names = append(names, attr.Name.Local)
+		values = append(values, attr.Value)
+	}
+	for _, child := range record.Children {
+		names = append(names, child.XMLName.Local)
+		values = append(values, strings.TrimSpace(child.Content))
+	}
+	return names, values
+}
@@ -438,6 +438,110 @@ func (x *RegisterDatabaseTableResponse) GetError() string {
 	return ""
 }
 
+type RegisterQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`   // Path in the data Trie
+	Query         *Query                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // Query details
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterQueryRequest) Reset() {
+	*x = RegisterQueryRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterQueryRequest) ProtoMessage() {}
+
+func (x *RegisterQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterQueryRequest.ProtoReflect.Descriptor instead.
+func (*RegisterQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RegisterQueryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RegisterQueryRequest) GetQuery() *Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type RegisterQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterQueryResponse) Reset() {
+	*x = RegisterQueryResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterQueryResponse) ProtoMessage() {}
+
+func (x *RegisterQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterQueryResponse.ProtoReflect.Descriptor instead.
+func (*RegisterQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RegisterQueryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterQueryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type StoreValueRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Path in the data Trie
@@ -446,6 +550,7 @@ type StoreValueRequest struct {
 	//	*StoreValueRequest_StringValue
 	//	*StoreValueRequest_IntValue
 	//	*StoreValueRequest_FloatValue
+	//	*StoreValueRequest_BoolValue
 	Value         isStoreValueRequest_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -453,7 +558,7 @@ type StoreValueRequest struct {
 
 func (x *StoreValueRequest) Reset() {
 	*x = StoreValueRequest{}
-	mi := &file_proto_nexus_proto_msgTypes[8]
+	mi := &file_proto_nexus_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +570,7 @@ func (x *StoreValueRequest) String() string {
 func (*StoreValueRequest) ProtoMessage() {}
 
 func (x *StoreValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[8]
+	mi := &file_proto_nexus_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +583,7 @@ func (x *StoreValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreValueRequest.ProtoReflect.Descriptor instead.
 func (*StoreValueRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{8}
+	return file_proto_nexus_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StoreValueRequest) GetPath() string {
@@ -522,6 +627,15 @@ func (x *StoreValueRequest) GetFloatValue() *FloatValue {
 	return nil
 }
 
+func (x *StoreValueRequest) GetBoolValue() *BoolValue {
+	if x != nil {
+		if x, ok := x.Value.(*StoreValueRequest_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return nil
+}
+
 type isStoreValueRequest_Value interface {
 	isStoreValueRequest_Value()
 }
@@ -538,12 +652,18 @@ type StoreValueRequest_FloatValue struct {
 	FloatValue *FloatValue `protobuf:"bytes,4,opt,name=float_value,json=floatValue,proto3,oneof"` // Example of a specific type
 }
 
+type StoreValueRequest_BoolValue struct {
+	BoolValue *BoolValue `protobuf:"bytes,5,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
 func (*StoreValueRequest_StringValue) isStoreValueRequest_Value() {}
 
 func (*StoreValueRequest_IntValue) isStoreValueRequest_Value() {}
 
 func (*StoreValueRequest_FloatValue) isStoreValueRequest_Value() {}
 
+func (*StoreValueRequest_BoolValue) isStoreValueRequest_Value() {}
+
 type StoreValueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -554,7 +674,7 @@ type StoreValueResponse struct {
 
 func (x *StoreValueResponse) Reset() {
 	*x = StoreValueResponse{}
-	mi := &file_proto_nexus_proto_msgTypes[9]
+	mi := &file_proto_nexus_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +686,7 @@ func (x *StoreValueResponse) String() string {
 func (*StoreValueResponse) ProtoMessage() {}
 
 func (x *StoreValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[9]
+	mi := &file_proto_nexus_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +699,7 @@ func (x *StoreValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoreValueResponse.ProtoReflect.Descriptor instead.
 func (*StoreValueResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{9}
+	return file_proto_nexus_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StoreValueResponse) GetSuccess() bool {
@@ -605,7 +725,7 @@ type DeletePathRequest struct {
 
 func (x *DeletePathRequest) Reset() {
 	*x = DeletePathRequest{}
-	mi := &file_proto_nexus_proto_msgTypes[10]
+	mi := &file_proto_nexus_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +737,7 @@ func (x *DeletePathRequest) String() string {
 func (*DeletePathRequest) ProtoMessage() {}
 
 func (x *DeletePathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[10]
+	mi := &file_proto_nexus_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +750,7 @@ func (x *DeletePathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePathRequest.ProtoReflect.Descriptor instead.
 func (*DeletePathRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{10}
+	return file_proto_nexus_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeletePathRequest) GetPath() string {
@@ -644,13 +764,14 @@ type DeletePathResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Dependents    []string               `protobuf:"bytes,3,rep,name=dependents,proto3" json:"dependents,omitempty"` // Paths that depended on the deleted path, directly or indirectly
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeletePathResponse) Reset() {
 	*x = DeletePathResponse{}
-	mi := &file_proto_nexus_proto_msgTypes[11]
+	mi := &file_proto_nexus_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +783,7 @@ func (x *DeletePathResponse) String() string {
 func (*DeletePathResponse) ProtoMessage() {}
 
 func (x *DeletePathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[11]
+	mi := &file_proto_nexus_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +796,7 @@ func (x *DeletePathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePathResponse.ProtoReflect.Descriptor instead.
 func (*DeletePathResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{11}
+	return file_proto_nexus_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeletePathResponse) GetSuccess() bool {
@@ -692,30 +813,36 @@ func (x *DeletePathResponse) GetError() string {
 	return ""
 }
 
-// New message types
-type EventStream struct {
+func (x *DeletePathResponse) GetDependents() []string {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+type DeclareLineageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Server        string                 `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"` // Kafka server address
-	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`   // Kafka topic name
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`         // Path of the node whose upstream dependencies are declared
+	Upstream      []string               `protobuf:"bytes,2,rep,name=upstream,proto3" json:"upstream,omitempty"` // Paths the node is derived from, replacing any declared before
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventStream) Reset() {
-	*x = EventStream{}
-	mi := &file_proto_nexus_proto_msgTypes[12]
+func (x *DeclareLineageRequest) Reset() {
+	*x = DeclareLineageRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventStream) String() string {
+func (x *DeclareLineageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStream) ProtoMessage() {}
+func (*DeclareLineageRequest) ProtoMessage() {}
 
-func (x *EventStream) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[12]
+func (x *DeclareLineageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,52 +853,48 @@ func (x *EventStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStream.ProtoReflect.Descriptor instead.
-func (*EventStream) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeclareLineageRequest.ProtoReflect.Descriptor instead.
+func (*DeclareLineageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EventStream) GetServer() string {
+func (x *DeclareLineageRequest) GetPath() string {
 	if x != nil {
-		return x.Server
+		return x.Path
 	}
 	return ""
 }
 
-func (x *EventStream) GetTopic() string {
+func (x *DeclareLineageRequest) GetUpstream() []string {
 	if x != nil {
-		return x.Topic
+		return x.Upstream
 	}
-	return ""
+	return nil
 }
 
-type Dataset struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Dataset:
-	//
-	//	*Dataset_IndividualFile
-	//	*Dataset_Directory
-	//	*Dataset_DatabaseTable
-	Dataset       isDataset_Dataset `protobuf_oneof:"dataset"`
+type DeclareLineageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Dataset) Reset() {
-	*x = Dataset{}
-	mi := &file_proto_nexus_proto_msgTypes[13]
+func (x *DeclareLineageResponse) Reset() {
+	*x = DeclareLineageResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Dataset) String() string {
+func (x *DeclareLineageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dataset) ProtoMessage() {}
+func (*DeclareLineageResponse) ProtoMessage() {}
 
-func (x *Dataset) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[13]
+func (x *DeclareLineageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,91 +905,61 @@ func (x *Dataset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dataset.ProtoReflect.Descriptor instead.
-func (*Dataset) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *Dataset) GetDataset() isDataset_Dataset {
-	if x != nil {
-		return x.Dataset
-	}
-	return nil
-}
-
-func (x *Dataset) GetIndividualFile() *IndividualFile {
-	if x != nil {
-		if x, ok := x.Dataset.(*Dataset_IndividualFile); ok {
-			return x.IndividualFile
-		}
-	}
-	return nil
+// Deprecated: Use DeclareLineageResponse.ProtoReflect.Descriptor instead.
+func (*DeclareLineageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Dataset) GetDirectory() *Directory {
+func (x *DeclareLineageResponse) GetSuccess() bool {
 	if x != nil {
-		if x, ok := x.Dataset.(*Dataset_Directory); ok {
-			return x.Directory
-		}
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *Dataset) GetDatabaseTable() *DatabaseTable {
+func (x *DeclareLineageResponse) GetError() string {
 	if x != nil {
-		if x, ok := x.Dataset.(*Dataset_DatabaseTable); ok {
-			return x.DatabaseTable
-		}
+		return x.Error
 	}
-	return nil
-}
-
-type isDataset_Dataset interface {
-	isDataset_Dataset()
-}
-
-type Dataset_IndividualFile struct {
-	IndividualFile *IndividualFile `protobuf:"bytes,1,opt,name=individual_file,json=individualFile,proto3,oneof"`
-}
-
-type Dataset_Directory struct {
-	Directory *Directory `protobuf:"bytes,2,opt,name=directory,proto3,oneof"`
-}
-
-type Dataset_DatabaseTable struct {
-	DatabaseTable *DatabaseTable `protobuf:"bytes,3,opt,name=database_table,json=databaseTable,proto3,oneof"`
+	return ""
 }
 
-func (*Dataset_IndividualFile) isDataset_Dataset() {}
-
-func (*Dataset_Directory) isDataset_Dataset() {}
-
-func (*Dataset_DatabaseTable) isDataset_Dataset() {}
-
-type IndividualFile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileType      string                 `protobuf:"bytes,1,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`          // e.g., "csv", "json"
-	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`          // Path to the file
-	ColumnNames   []string               `protobuf:"bytes,3,rep,name=column_names,json=columnNames,proto3" json:"column_names,omitempty"` // Optional column names
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// New message types
+type EventStream struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Server         string                 `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`                                         // Server address, only used if brokers is empty
+	Topic          string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`                                           // Kafka topic, NATS subject, MQTT topic or Redis stream key
+	DefaultGroupId string                 `protobuf:"bytes,3,opt,name=default_group_id,json=defaultGroupId,proto3" json:"default_group_id,omitempty"` // Consumer group joined by consumers that don't name one, none if empty
+	Brokers        []string               `protobuf:"bytes,4,rep,name=brokers,proto3" json:"brokers,omitempty"`                                       // Addresses of the transport's servers, such as Kafka bootstrap brokers
+	ClientId       string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                     // Client ID reported to the brokers, sarama's default if empty
+	KafkaVersion   string                 `protobuf:"bytes,6,opt,name=kafka_version,json=kafkaVersion,proto3" json:"kafka_version,omitempty"`         // Kafka version the brokers run, e.g. "3.6.0", sarama's default if empty
+	Compression    string                 `protobuf:"bytes,7,opt,name=compression,proto3" json:"compression,omitempty"`                               // Codec messages are produced with: none, gzip, snappy, lz4 or zstd
+	Tls            *KafkaTLS              `protobuf:"bytes,8,opt,name=tls,proto3" json:"tls,omitempty"`                                               // Connect to the servers over TLS, plaintext if unset
+	Sasl           *KafkaSASL             `protobuf:"bytes,9,opt,name=sasl,proto3" json:"sasl,omitempty"`                                             // Authenticate with SASL, other transports only use its credential, unauthenticated if unset
+	Transport      string                 `protobuf:"bytes,10,opt,name=transport,proto3" json:"transport,omitempty"`                                  // kafka, nats, mqtt, redis or inproc, kafka if empty
+	Encoding       string                 `protobuf:"bytes,11,opt,name=encoding,proto3" json:"encoding,omitempty"`                                    // Encoding of message values: utf8, json, protobuf, avro or msgpack, undeclared if empty
+	Schema         string                 `protobuf:"bytes,12,opt,name=schema,proto3" json:"schema,omitempty"`                                        // Avro schema of the values, or the full name of their protobuf message type
+	DescriptorSet  []byte                 `protobuf:"bytes,13,opt,name=descriptor_set,json=descriptorSet,proto3" json:"descriptor_set,omitempty"`     // Serialized FileDescriptorSet defining the protobuf message type, if it isn't compiled in
+	LastValue      *LastValueCache        `protobuf:"bytes,14,opt,name=last_value,json=lastValue,proto3" json:"last_value,omitempty"`                 // Keep the latest message in a value node, no cache if unset
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *IndividualFile) Reset() {
-	*x = IndividualFile{}
-	mi := &file_proto_nexus_proto_msgTypes[14]
+func (x *EventStream) Reset() {
+	*x = EventStream{}
+	mi := &file_proto_nexus_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IndividualFile) String() string {
+func (x *EventStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndividualFile) ProtoMessage() {}
+func (*EventStream) ProtoMessage() {}
 
-func (x *IndividualFile) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[14]
+func (x *EventStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,118 +970,136 @@ func (x *IndividualFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndividualFile.ProtoReflect.Descriptor instead.
-func (*IndividualFile) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use EventStream.ProtoReflect.Descriptor instead.
+func (*EventStream) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *IndividualFile) GetFileType() string {
+func (x *EventStream) GetServer() string {
 	if x != nil {
-		return x.FileType
+		return x.Server
 	}
 	return ""
 }
 
-func (x *IndividualFile) GetFilePath() string {
+func (x *EventStream) GetTopic() string {
 	if x != nil {
-		return x.FilePath
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *IndividualFile) GetColumnNames() []string {
+func (x *EventStream) GetDefaultGroupId() string {
 	if x != nil {
-		return x.ColumnNames
+		return x.DefaultGroupId
+	}
+	return ""
+}
+
+func (x *EventStream) GetBrokers() []string {
+	if x != nil {
+		return x.Brokers
 	}
 	return nil
 }
 
-type Directory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileType      string                 `protobuf:"bytes,1,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`                // e.g., "csv", "json"
-	DirectoryPath string                 `protobuf:"bytes,2,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"` // Path to the directory
-	FileCount     int32                  `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`            // Number of files
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventStream) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
 }
 
-func (x *Directory) Reset() {
-	*x = Directory{}
-	mi := &file_proto_nexus_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EventStream) GetKafkaVersion() string {
+	if x != nil {
+		return x.KafkaVersion
+	}
+	return ""
 }
 
-func (x *Directory) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventStream) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
 }
 
-func (*Directory) ProtoMessage() {}
+func (x *EventStream) GetTls() *KafkaTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
 
-func (x *Directory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[15]
+func (x *EventStream) GetSasl() *KafkaSASL {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sasl
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Directory.ProtoReflect.Descriptor instead.
-func (*Directory) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{15}
+func (x *EventStream) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
 }
 
-func (x *Directory) GetFileType() string {
+func (x *EventStream) GetEncoding() string {
 	if x != nil {
-		return x.FileType
+		return x.Encoding
 	}
 	return ""
 }
 
-func (x *Directory) GetDirectoryPath() string {
+func (x *EventStream) GetSchema() string {
 	if x != nil {
-		return x.DirectoryPath
+		return x.Schema
 	}
 	return ""
 }
 
-func (x *Directory) GetFileCount() int32 {
+func (x *EventStream) GetDescriptorSet() []byte {
 	if x != nil {
-		return x.FileCount
+		return x.DescriptorSet
 	}
-	return 0
+	return nil
 }
 
-type DatabaseTable struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DbType        string                 `protobuf:"bytes,1,opt,name=db_type,json=dbType,proto3" json:"db_type,omitempty"`          // e.g., "Postgres"
-	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`                            // Database server address
-	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`                           // Database server port
-	DbName        string                 `protobuf:"bytes,4,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`          // Database name
-	TableName     string                 `protobuf:"bytes,5,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"` // Table name
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EventStream) GetLastValue() *LastValueCache {
+	if x != nil {
+		return x.LastValue
+	}
+	return nil
 }
 
-func (x *DatabaseTable) Reset() {
-	*x = DatabaseTable{}
-	mi := &file_proto_nexus_proto_msgTypes[16]
+// TLS settings of a Kafka connection
+type KafkaTLS struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CaFile             string                 `protobuf:"bytes,1,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`                                        // PEM file of the CAs brokers are verified with, the system's if empty
+	CertFile           string                 `protobuf:"bytes,2,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`                                  // PEM client certificate presented to the brokers, for mutual TLS
+	KeyFile            string                 `protobuf:"bytes,3,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`                                     // PEM private key of the client certificate
+	ServerName         string                 `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`                            // Name verified in broker certificates, the broker's host if empty
+	InsecureSkipVerify bool                   `protobuf:"varint,5,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"` // Don't verify broker certificates
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *KafkaTLS) Reset() {
+	*x = KafkaTLS{}
+	mi := &file_proto_nexus_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseTable) String() string {
+func (x *KafkaTLS) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseTable) ProtoMessage() {}
+func (*KafkaTLS) ProtoMessage() {}
 
-func (x *DatabaseTable) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[16]
+func (x *KafkaTLS) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,69 +1110,70 @@ func (x *DatabaseTable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseTable.ProtoReflect.Descriptor instead.
-func (*DatabaseTable) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use KafkaTLS.ProtoReflect.Descriptor instead.
+func (*KafkaTLS) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DatabaseTable) GetDbType() string {
+func (x *KafkaTLS) GetCaFile() string {
 	if x != nil {
-		return x.DbType
+		return x.CaFile
 	}
 	return ""
 }
 
-func (x *DatabaseTable) GetHost() string {
+func (x *KafkaTLS) GetCertFile() string {
 	if x != nil {
-		return x.Host
+		return x.CertFile
 	}
 	return ""
 }
 
-func (x *DatabaseTable) GetPort() int32 {
+func (x *KafkaTLS) GetKeyFile() string {
 	if x != nil {
-		return x.Port
+		return x.KeyFile
 	}
-	return 0
+	return ""
 }
 
-func (x *DatabaseTable) GetDbName() string {
+func (x *KafkaTLS) GetServerName() string {
 	if x != nil {
-		return x.DbName
+		return x.ServerName
 	}
 	return ""
 }
 
-func (x *DatabaseTable) GetTableName() string {
+func (x *KafkaTLS) GetInsecureSkipVerify() bool {
 	if x != nil {
-		return x.TableName
+		return x.InsecureSkipVerify
 	}
-	return ""
+	return false
 }
 
-// Define a message for string values
-type StringValue struct {
+// SASL authentication of a Kafka connection
+type KafkaSASL struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Mechanism     string                 `protobuf:"bytes,1,opt,name=mechanism,proto3" json:"mechanism,omitempty"`                              // PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, PLAIN if empty
+	CredentialRef string                 `protobuf:"bytes,2,opt,name=credential_ref,json=credentialRef,proto3" json:"credential_ref,omitempty"` // Reference to the username and password, e.g. "env:KAFKA" or "file:prod-kafka"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StringValue) Reset() {
-	*x = StringValue{}
-	mi := &file_proto_nexus_proto_msgTypes[17]
+func (x *KafkaSASL) Reset() {
+	*x = KafkaSASL{}
+	mi := &file_proto_nexus_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StringValue) String() string {
+func (x *KafkaSASL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StringValue) ProtoMessage() {}
+func (*KafkaSASL) ProtoMessage() {}
 
-func (x *StringValue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[17]
+func (x *KafkaSASL) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,41 +1184,50 @@ func (x *StringValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StringValue.ProtoReflect.Descriptor instead.
-func (*StringValue) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use KafkaSASL.ProtoReflect.Descriptor instead.
+func (*KafkaSASL) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StringValue) GetValue() string {
+func (x *KafkaSASL) GetMechanism() string {
 	if x != nil {
-		return x.Value
+		return x.Mechanism
 	}
 	return ""
 }
 
-// Define a message for integer values
-type IntValue struct {
+func (x *KafkaSASL) GetCredentialRef() string {
+	if x != nil {
+		return x.CredentialRef
+	}
+	return ""
+}
+
+// Server-side cache of the latest message of an event stream. Booleans and numbers are kept as
+// bool, int or float values, integers outside the int range and other values as string values.
+type LastValueCache struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"` // or int64, depending on your needs
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`   // Path of the value node, "<stream path>/latest" if empty
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"` // Dot separated field of the decoded message to keep, the whole message if empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IntValue) Reset() {
-	*x = IntValue{}
-	mi := &file_proto_nexus_proto_msgTypes[18]
+func (x *LastValueCache) Reset() {
+	*x = LastValueCache{}
+	mi := &file_proto_nexus_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntValue) String() string {
+func (x *LastValueCache) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntValue) ProtoMessage() {}
+func (*LastValueCache) ProtoMessage() {}
 
-func (x *IntValue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[18]
+func (x *LastValueCache) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1117,41 +1238,52 @@ func (x *IntValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntValue.ProtoReflect.Descriptor instead.
-func (*IntValue) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LastValueCache.ProtoReflect.Descriptor instead.
+func (*LastValueCache) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *IntValue) GetValue() int32 {
+func (x *LastValueCache) GetPath() string {
 	if x != nil {
-		return x.Value
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-// Define a message for float values
-type FloatValue struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         float32                `protobuf:"fixed32,1,opt,name=value,proto3" json:"value,omitempty"` // or double, depending on your needs
+func (x *LastValueCache) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type Dataset struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Dataset:
+	//
+	//	*Dataset_IndividualFile
+	//	*Dataset_Directory
+	//	*Dataset_DatabaseTable
+	Dataset       isDataset_Dataset `protobuf_oneof:"dataset"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FloatValue) Reset() {
-	*x = FloatValue{}
-	mi := &file_proto_nexus_proto_msgTypes[19]
+func (x *Dataset) Reset() {
+	*x = Dataset{}
+	mi := &file_proto_nexus_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FloatValue) String() string {
+func (x *Dataset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FloatValue) ProtoMessage() {}
+func (*Dataset) ProtoMessage() {}
 
-func (x *FloatValue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[19]
+func (x *Dataset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,41 +1294,95 @@ func (x *FloatValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FloatValue.ProtoReflect.Descriptor instead.
-func (*FloatValue) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Dataset.ProtoReflect.Descriptor instead.
+func (*Dataset) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *FloatValue) GetValue() float32 {
+func (x *Dataset) GetDataset() isDataset_Dataset {
 	if x != nil {
-		return x.Value
+		return x.Dataset
 	}
-	return 0
+	return nil
 }
 
-// Request/Response messages for Consumers
-type SubscribeRequest struct {
+func (x *Dataset) GetIndividualFile() *IndividualFile {
+	if x != nil {
+		if x, ok := x.Dataset.(*Dataset_IndividualFile); ok {
+			return x.IndividualFile
+		}
+	}
+	return nil
+}
+
+func (x *Dataset) GetDirectory() *Directory {
+	if x != nil {
+		if x, ok := x.Dataset.(*Dataset_Directory); ok {
+			return x.Directory
+		}
+	}
+	return nil
+}
+
+func (x *Dataset) GetDatabaseTable() *DatabaseTable {
+	if x != nil {
+		if x, ok := x.Dataset.(*Dataset_DatabaseTable); ok {
+			return x.DatabaseTable
+		}
+	}
+	return nil
+}
+
+type isDataset_Dataset interface {
+	isDataset_Dataset()
+}
+
+type Dataset_IndividualFile struct {
+	IndividualFile *IndividualFile `protobuf:"bytes,1,opt,name=individual_file,json=individualFile,proto3,oneof"`
+}
+
+type Dataset_Directory struct {
+	Directory *Directory `protobuf:"bytes,2,opt,name=directory,proto3,oneof"`
+}
+
+type Dataset_DatabaseTable struct {
+	DatabaseTable *DatabaseTable `protobuf:"bytes,3,opt,name=database_table,json=databaseTable,proto3,oneof"`
+}
+
+func (*Dataset_IndividualFile) isDataset_Dataset() {}
+
+func (*Dataset_Directory) isDataset_Dataset() {}
+
+func (*Dataset_DatabaseTable) isDataset_Dataset() {}
+
+type IndividualFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	FileType      string                 `protobuf:"bytes,1,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`          // e.g., "csv", "json"
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`          // Path to the file
+	ColumnNames   []string               `protobuf:"bytes,3,rep,name=column_names,json=columnNames,proto3" json:"column_names,omitempty"` // Optional column names
+	ColumnTypes   []string               `protobuf:"bytes,4,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty"` // Inferred column types, parallel to column_names
+	RowCount      int64                  `protobuf:"varint,5,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`         // Number of data rows, if inferred
+	FileSize      int64                  `protobuf:"varint,6,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`         // File size in bytes, if inferred
+	Fingerprint   *Fingerprint           `protobuf:"bytes,7,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                    // Fingerprint of the file's contents, kept current by the server
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
-	mi := &file_proto_nexus_proto_msgTypes[20]
+func (x *IndividualFile) Reset() {
+	*x = IndividualFile{}
+	mi := &file_proto_nexus_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeRequest) String() string {
+func (x *IndividualFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+func (*IndividualFile) ProtoMessage() {}
 
-func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[20]
+func (x *IndividualFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,40 +1393,1916 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use IndividualFile.ProtoReflect.Descriptor instead.
+func (*IndividualFile) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SubscribeRequest) GetPath() string {
+func (x *IndividualFile) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *IndividualFile) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *IndividualFile) GetColumnNames() []string {
+	if x != nil {
+		return x.ColumnNames
+	}
+	return nil
+}
+
+func (x *IndividualFile) GetColumnTypes() []string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *IndividualFile) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *IndividualFile) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *IndividualFile) GetFingerprint() *Fingerprint {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return nil
+}
+
+type Directory struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FileType        string                 `protobuf:"bytes,1,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`                      // e.g., "csv", "json"
+	DirectoryPath   string                 `protobuf:"bytes,2,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`       // Path to the directory
+	FileCount       int32                  `protobuf:"varint,3,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`                  // Number of files
+	ColumnNames     []string               `protobuf:"bytes,4,rep,name=column_names,json=columnNames,proto3" json:"column_names,omitempty"`             // Column names shared by the files, if inferred
+	ColumnTypes     []string               `protobuf:"bytes,5,rep,name=column_types,json=columnTypes,proto3" json:"column_types,omitempty"`             // Inferred column types, parallel to column_names
+	RowCount        int64                  `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`                     // Total number of data rows across all files
+	TotalSize       int64                  `protobuf:"varint,7,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`                  // Total size of all files in bytes
+	MismatchedFiles []string               `protobuf:"bytes,8,rep,name=mismatched_files,json=mismatchedFiles,proto3" json:"mismatched_files,omitempty"` // Files whose schema differs from the first file
+	LastUpdated     int64                  `protobuf:"varint,9,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`            // Unix time in seconds of the latest change to the directory's files
+	Fingerprint     *Fingerprint           `protobuf:"bytes,10,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                               // Fingerprint of the directory's files, kept current by the server
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Directory) Reset() {
+	*x = Directory{}
+	mi := &file_proto_nexus_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Directory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Directory) ProtoMessage() {}
+
+func (x *Directory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Directory.ProtoReflect.Descriptor instead.
+func (*Directory) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Directory) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *Directory) GetDirectoryPath() string {
+	if x != nil {
+		return x.DirectoryPath
+	}
+	return ""
+}
+
+func (x *Directory) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *Directory) GetColumnNames() []string {
+	if x != nil {
+		return x.ColumnNames
+	}
+	return nil
+}
+
+func (x *Directory) GetColumnTypes() []string {
+	if x != nil {
+		return x.ColumnTypes
+	}
+	return nil
+}
+
+func (x *Directory) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *Directory) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *Directory) GetMismatchedFiles() []string {
+	if x != nil {
+		return x.MismatchedFiles
+	}
+	return nil
+}
+
+func (x *Directory) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *Directory) GetFingerprint() *Fingerprint {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return nil
+}
+
+// Identifies the contents of a file or directory, so changes can be detected without reading it
+type Fingerprint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          int64                  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`                               // Size in bytes, summed over the files of a directory
+	ModTime       int64                  `protobuf:"varint,2,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`          // Latest modification time in Unix microseconds
+	Hash          string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`                                // Hex encoded content hash
+	Algorithm     string                 `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                      // Hash algorithm, e.g. "sha256"
+	ComputedAt    int64                  `protobuf:"varint,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"` // Unix time in seconds the hash was computed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fingerprint) Reset() {
+	*x = Fingerprint{}
+	mi := &file_proto_nexus_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fingerprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fingerprint) ProtoMessage() {}
+
+func (x *Fingerprint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fingerprint.ProtoReflect.Descriptor instead.
+func (*Fingerprint) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Fingerprint) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Fingerprint) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Fingerprint) GetComputedAt() int64 {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return 0
+}
+
+type DatabaseTable struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DbType          string                 `protobuf:"bytes,1,opt,name=db_type,json=dbType,proto3" json:"db_type,omitempty"`                              // e.g., "Postgres"
+	Host            string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`                                                // Database server address
+	Port            int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`                                               // Database server port
+	DbName          string                 `protobuf:"bytes,4,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`                              // Database name
+	TableName       string                 `protobuf:"bytes,5,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`                     // Table name
+	CredentialRef   string                 `protobuf:"bytes,6,opt,name=credential_ref,json=credentialRef,proto3" json:"credential_ref,omitempty"`         // Reference to the credentials used to connect, e.g. "env:ANALYTICS" or "file:prod-db"
+	SchemaName      string                 `protobuf:"bytes,7,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`                  // Schema containing the table, the connection's default schema if empty
+	Columns         []string               `protobuf:"bytes,8,rep,name=columns,proto3" json:"columns,omitempty"`                                          // Columns returned by default, all columns if empty
+	Filters         []*Filter              `protobuf:"bytes,9,rep,name=filters,proto3" json:"filters,omitempty"`                                          // Predicates every returned row must satisfy
+	OrderBy         string                 `protobuf:"bytes,10,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`                          // Column to sort rows by, unsorted if empty
+	OrderDescending bool                   `protobuf:"varint,11,opt,name=order_descending,json=orderDescending,proto3" json:"order_descending,omitempty"` // Sort in descending rather than ascending order
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DatabaseTable) Reset() {
+	*x = DatabaseTable{}
+	mi := &file_proto_nexus_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseTable) ProtoMessage() {}
+
+func (x *DatabaseTable) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseTable.ProtoReflect.Descriptor instead.
+func (*DatabaseTable) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DatabaseTable) GetDbType() string {
+	if x != nil {
+		return x.DbType
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DatabaseTable) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetCredentialRef() string {
+	if x != nil {
+		return x.CredentialRef
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DatabaseTable) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *DatabaseTable) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *DatabaseTable) GetOrderDescending() bool {
+	if x != nil {
+		return x.OrderDescending
+	}
+	return false
+}
+
+// A parameterized SQL statement run on the connection of a registered database table
+type Query struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionPath string                 `protobuf:"bytes,1,opt,name=connection_path,json=connectionPath,proto3" json:"connection_path,omitempty"` // Path of the DatabaseTable whose connection the query runs on
+	Sql            string                 `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`                                             // SQL statement referring to its parameters as :name
+	Parameters     []*QueryParameter      `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`                               // Parameters the statement accepts
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	mi := &file_proto_nexus_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Query) GetConnectionPath() string {
+	if x != nil {
+		return x.ConnectionPath
+	}
+	return ""
+}
+
+func (x *Query) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *Query) GetParameters() []*QueryParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type QueryParameter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                           // One of string, int, float or bool
+	DefaultValue  *string                `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"` // Used when the caller omits the parameter, which is required if unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryParameter) Reset() {
+	*x = QueryParameter{}
+	mi := &file_proto_nexus_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParameter) ProtoMessage() {}
+
+func (x *QueryParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryParameter.ProtoReflect.Descriptor instead.
+func (*QueryParameter) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueryParameter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QueryParameter) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+// Define a message for string values
+type StringValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringValue) Reset() {
+	*x = StringValue{}
+	mi := &file_proto_nexus_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringValue) ProtoMessage() {}
+
+func (x *StringValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringValue.ProtoReflect.Descriptor instead.
+func (*StringValue) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StringValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Define a message for integer values
+type IntValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"` // or int64, depending on your needs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntValue) Reset() {
+	*x = IntValue{}
+	mi := &file_proto_nexus_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntValue) ProtoMessage() {}
+
+func (x *IntValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntValue.ProtoReflect.Descriptor instead.
+func (*IntValue) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *IntValue) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Define a message for float values
+type FloatValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float32                `protobuf:"fixed32,1,opt,name=value,proto3" json:"value,omitempty"` // or double, depending on your needs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatValue) Reset() {
+	*x = FloatValue{}
+	mi := &file_proto_nexus_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatValue) ProtoMessage() {}
+
+func (x *FloatValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatValue.ProtoReflect.Descriptor instead.
+func (*FloatValue) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FloatValue) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Define a message for boolean values
+type BoolValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolValue) Reset() {
+	*x = BoolValue{}
+	mi := &file_proto_nexus_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolValue) ProtoMessage() {}
+
+func (x *BoolValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolValue.ProtoReflect.Descriptor instead.
+func (*BoolValue) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BoolValue) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// Request/Response messages for Consumers
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Recursive     bool                   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"` // Also receive events for the paths below path
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubscribeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`               // Path of the node the event is about
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`               // One of registered, changed or deleted
+	Fingerprint   *Fingerprint           `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // New fingerprint of a changed file or directory
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`    // Unix time in milliseconds the event happened
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_proto_nexus_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Event) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Event) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetFingerprint() *Fingerprint {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return nil
+}
+
+func (x *Event) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// New unified request message
+type GetPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Path in the data Trie
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPathRequest) Reset() {
+	*x = GetPathRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPathRequest) ProtoMessage() {}
+
+func (x *GetPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPathRequest.ProtoReflect.Descriptor instead.
+func (*GetPathRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPathRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetNodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*GetNodeResponse_StringValue
+	//	*GetNodeResponse_IntValue
+	//	*GetNodeResponse_FloatValue
+	//	*GetNodeResponse_IndividualFile
+	//	*GetNodeResponse_Directory
+	//	*GetNodeResponse_DatabaseTable
+	//	*GetNodeResponse_EventStream
+	//	*GetNodeResponse_Query
+	//	*GetNodeResponse_BoolValue
+	Value         isGetNodeResponse_Value `protobuf_oneof:"value"`
+	ValueType     string                  `protobuf:"bytes,8,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	IsEndOfPath   bool                    `protobuf:"varint,9,opt,name=is_end_of_path,json=isEndOfPath,proto3" json:"is_end_of_path,omitempty"`
+	Error         string                  `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`   // Error message if any
+	Health        *HealthStatus           `protobuf:"bytes,12,opt,name=health,proto3" json:"health,omitempty"` // Health of the node's resource, unset for values and internal nodes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResponse) Reset() {
+	*x = GetNodeResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResponse) ProtoMessage() {}
+
+func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetNodeResponse) GetValue() isGetNodeResponse_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetStringValue() *StringValue {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetIntValue() *IntValue {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetFloatValue() *FloatValue {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetIndividualFile() *IndividualFile {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_IndividualFile); ok {
+			return x.IndividualFile
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetDirectory() *Directory {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_Directory); ok {
+			return x.Directory
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetDatabaseTable() *DatabaseTable {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_DatabaseTable); ok {
+			return x.DatabaseTable
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetEventStream() *EventStream {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_EventStream); ok {
+			return x.EventStream
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetQuery() *Query {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_Query); ok {
+			return x.Query
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetBoolValue() *BoolValue {
+	if x != nil {
+		if x, ok := x.Value.(*GetNodeResponse_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return nil
+}
+
+func (x *GetNodeResponse) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *GetNodeResponse) GetIsEndOfPath() bool {
+	if x != nil {
+		return x.IsEndOfPath
+	}
+	return false
+}
+
+func (x *GetNodeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetNodeResponse) GetHealth() *HealthStatus {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type isGetNodeResponse_Value interface {
+	isGetNodeResponse_Value()
+}
+
+type GetNodeResponse_StringValue struct {
+	StringValue *StringValue `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type GetNodeResponse_IntValue struct {
+	IntValue *IntValue `protobuf:"bytes,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type GetNodeResponse_FloatValue struct {
+	FloatValue *FloatValue `protobuf:"bytes,3,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type GetNodeResponse_IndividualFile struct {
+	IndividualFile *IndividualFile `protobuf:"bytes,4,opt,name=individual_file,json=individualFile,proto3,oneof"`
+}
+
+type GetNodeResponse_Directory struct {
+	Directory *Directory `protobuf:"bytes,5,opt,name=directory,proto3,oneof"`
+}
+
+type GetNodeResponse_DatabaseTable struct {
+	DatabaseTable *DatabaseTable `protobuf:"bytes,6,opt,name=database_table,json=databaseTable,proto3,oneof"`
+}
+
+type GetNodeResponse_EventStream struct {
+	EventStream *EventStream `protobuf:"bytes,7,opt,name=event_stream,json=eventStream,proto3,oneof"`
+}
+
+type GetNodeResponse_Query struct {
+	Query *Query `protobuf:"bytes,11,opt,name=query,proto3,oneof"`
+}
+
+type GetNodeResponse_BoolValue struct {
+	BoolValue *BoolValue `protobuf:"bytes,13,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*GetNodeResponse_StringValue) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_IntValue) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_FloatValue) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_IndividualFile) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_Directory) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_DatabaseTable) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_EventStream) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_Query) isGetNodeResponse_Value() {}
+
+func (*GetNodeResponse_BoolValue) isGetNodeResponse_Value() {}
+
+// Result of the server's latest probe of the resource behind a node
+type HealthStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                               // One of healthy, degraded, unhealthy, unchecked or unknown
+	LastChecked   int64                  `protobuf:"varint,2,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"` // Unix time in seconds of the latest check, 0 if never checked
+	LastError     string                 `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`        // Problem found by the latest check, empty if healthy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthStatus) Reset() {
+	*x = HealthStatus{}
+	mi := &file_proto_nexus_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthStatus) ProtoMessage() {}
+
+func (x *HealthStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthStatus.ProtoReflect.Descriptor instead.
+func (*HealthStatus) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HealthStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthStatus) GetLastChecked() int64 {
+	if x != nil {
+		return x.LastChecked
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// Common messages
+type AccessInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Info:
+	//
+	//	*AccessInfo_File
+	//	*AccessInfo_Database
+	Info          isAccessInfo_Info `protobuf_oneof:"info"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessInfo) Reset() {
+	*x = AccessInfo{}
+	mi := &file_proto_nexus_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessInfo) ProtoMessage() {}
+
+func (x *AccessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessInfo.ProtoReflect.Descriptor instead.
+func (*AccessInfo) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AccessInfo) GetInfo() isAccessInfo_Info {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *AccessInfo) GetFile() *FileInfo {
+	if x != nil {
+		if x, ok := x.Info.(*AccessInfo_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *AccessInfo) GetDatabase() *DatabaseInfo {
+	if x != nil {
+		if x, ok := x.Info.(*AccessInfo_Database); ok {
+			return x.Database
+		}
+	}
+	return nil
+}
+
+type isAccessInfo_Info interface {
+	isAccessInfo_Info()
+}
+
+type AccessInfo_File struct {
+	File *FileInfo `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type AccessInfo_Database struct {
+	Database *DatabaseInfo `protobuf:"bytes,2,opt,name=database,proto3,oneof"`
+}
+
+func (*AccessInfo_File) isAccessInfo_Info() {}
+
+func (*AccessInfo_Database) isAccessInfo_Info() {}
+
+type FileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filepath      string                 `protobuf:"bytes,1,opt,name=filepath,proto3" json:"filepath,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_proto_nexus_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FileInfo) GetFilepath() string {
+	if x != nil {
+		return x.Filepath
+	}
+	return ""
+}
+
+func (x *FileInfo) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type DatabaseInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionString string                 `protobuf:"bytes,1,opt,name=connection_string,json=connectionString,proto3" json:"connection_string,omitempty"`
+	Table            string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DatabaseInfo) Reset() {
+	*x = DatabaseInfo{}
+	mi := &file_proto_nexus_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseInfo) ProtoMessage() {}
+
+func (x *DatabaseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseInfo.ProtoReflect.Descriptor instead.
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DatabaseInfo) GetConnectionString() string {
+	if x != nil {
+		return x.ConnectionString
+	}
+	return ""
+}
+
+func (x *DatabaseInfo) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+// Request message for reading the rows of a dataset through the server
+type ReadDatasetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                                                                        // Path of the dataset in the data Trie
+	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`                                                                                  // Columns to return, all columns if empty
+	Filters       []*Filter              `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`                                                                                  // Predicates every returned row must satisfy
+	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`                                                                                   // Number of matching rows to skip
+	Limit         int64                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                                                                     // Maximum number of rows to return, unlimited if zero
+	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                                                            // Number of rows per response
+	Pattern       string                 `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"`                                                                                  // Directories only: glob selecting the files to read
+	SourceColumn  string                 `protobuf:"bytes,8,opt,name=source_column,json=sourceColumn,proto3" json:"source_column,omitempty"`                                                    // Directories only: column holding each row's source file
+	Partitioned   bool                   `protobuf:"varint,9,opt,name=partitioned,proto3" json:"partitioned,omitempty"`                                                                         // Directories only: read hive-style key=value subdirectories
+	Parameters    map[string]string      `protobuf:"bytes,10,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Queries only: values of the query parameters by name
+	IfChangedFrom string                 `protobuf:"bytes,11,opt,name=if_changed_from,json=ifChangedFrom,proto3" json:"if_changed_from,omitempty"`                                              // Files and directories only: skip the read if the fingerprint hash still equals this
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDatasetRequest) Reset() {
+	*x = ReadDatasetRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDatasetRequest) ProtoMessage() {}
+
+func (x *ReadDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDatasetRequest.ProtoReflect.Descriptor instead.
+func (*ReadDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReadDatasetRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadDatasetRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ReadDatasetRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *ReadDatasetRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadDatasetRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadDatasetRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ReadDatasetRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ReadDatasetRequest) GetSourceColumn() string {
+	if x != nil {
+		return x.SourceColumn
+	}
+	return ""
+}
+
+func (x *ReadDatasetRequest) GetPartitioned() bool {
+	if x != nil {
+		return x.Partitioned
+	}
+	return false
+}
+
+func (x *ReadDatasetRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *ReadDatasetRequest) GetIfChangedFrom() string {
+	if x != nil {
+		return x.IfChangedFrom
+	}
+	return ""
+}
+
+// Filter compares a column with a value
+type Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"` // One of =, !=, <, <=, >, >= or contains
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_proto_nexus_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Filter) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Filter) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Filter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Response message streaming the rows of a dataset in batches
+type ReadDatasetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*ColumnInfo          `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"` // Set on the first response only
+	Rows          []*Row                 `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                                 // Error message if any
+	Fingerprint   *Fingerprint           `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                     // Files and directories only: set on the first response
+	NotModified   bool                   `protobuf:"varint,5,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"` // The fingerprint hash still equals if_changed_from, so no columns or rows are sent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadDatasetResponse) Reset() {
+	*x = ReadDatasetResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDatasetResponse) ProtoMessage() {}
+
+func (x *ReadDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDatasetResponse.ProtoReflect.Descriptor instead.
+func (*ReadDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReadDatasetResponse) GetColumns() []*ColumnInfo {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ReadDatasetResponse) GetRows() []*Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ReadDatasetResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReadDatasetResponse) GetFingerprint() *Fingerprint {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return nil
+}
+
+func (x *ReadDatasetResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+type ColumnInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // One of string, int, float or bool
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnInfo) Reset() {
+	*x = ColumnInfo{}
+	mi := &file_proto_nexus_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnInfo) ProtoMessage() {}
+
+func (x *ColumnInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnInfo.ProtoReflect.Descriptor instead.
+func (*ColumnInfo) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ColumnInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColumnInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Row struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cells         []*Cell                `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Row) Reset() {
+	*x = Row{}
+	mi := &file_proto_nexus_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Row) ProtoMessage() {}
+
+func (x *Row) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Row.ProtoReflect.Descriptor instead.
+func (*Row) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Row) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// Cell holds a single typed value, with no value set for nulls
+type Cell struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Cell_StringValue
+	//	*Cell_IntValue
+	//	*Cell_FloatValue
+	//	*Cell_BoolValue
+	Value         isCell_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cell) Reset() {
+	*x = Cell{}
+	mi := &file_proto_nexus_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cell) ProtoMessage() {}
+
+func (x *Cell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cell.ProtoReflect.Descriptor instead.
+func (*Cell) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Cell) GetValue() isCell_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Cell) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*Cell_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *Cell) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*Cell_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *Cell) GetFloatValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*Cell_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+func (x *Cell) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*Cell_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+type isCell_Value interface {
+	isCell_Value()
+}
+
+type Cell_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type Cell_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type Cell_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,3,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type Cell_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*Cell_StringValue) isCell_Value() {}
+
+func (*Cell_IntValue) isCell_Value() {}
+
+func (*Cell_FloatValue) isCell_Value() {}
+
+func (*Cell_BoolValue) isCell_Value() {}
+
+// Request message for listing children
+type GetChildrenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Path in the data Trie
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildrenRequest) Reset() {
+	*x = GetChildrenRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildrenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildrenRequest) ProtoMessage() {}
+
+func (x *GetChildrenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildrenRequest.ProtoReflect.Descriptor instead.
+func (*GetChildrenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetChildrenRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Response message for listing children
+type GetChildrenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Children      []*ChildInfo           `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"` // List of child paths and their types
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChildrenResponse) Reset() {
+	*x = GetChildrenResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChildrenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildrenResponse) ProtoMessage() {}
+
+func (x *GetChildrenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildrenResponse.ProtoReflect.Descriptor instead.
+func (*GetChildrenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetChildrenResponse) GetChildren() []*ChildInfo {
 	if x != nil {
-		return x.Path
+		return x.Children
 	}
-	return ""
+	return nil
 }
 
-type Event struct {
+// New message to hold child information
+type ChildInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                // Name of the child
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                // Type of the child
+	NumChildren   int32                  `protobuf:"varint,3,opt,name=numChildren,proto3" json:"numChildren,omitempty"` // Number of children
+	Health        *HealthStatus          `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`            // Health of the child's resource, unset for values and internal nodes
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_proto_nexus_proto_msgTypes[21]
+func (x *ChildInfo) Reset() {
+	*x = ChildInfo{}
+	mi := &file_proto_nexus_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *ChildInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ChildInfo) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[21]
+func (x *ChildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,41 +3313,62 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ChildInfo.ProtoReflect.Descriptor instead.
+func (*ChildInfo) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Event) GetData() []byte {
+func (x *ChildInfo) GetName() string {
 	if x != nil {
-		return x.Data
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChildInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChildInfo) GetNumChildren() int32 {
+	if x != nil {
+		return x.NumChildren
+	}
+	return 0
+}
+
+func (x *ChildInfo) GetHealth() *HealthStatus {
+	if x != nil {
+		return x.Health
 	}
 	return nil
 }
 
-// New unified request message
-type GetPathRequest struct {
+type GetPathTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Path in the data Trie
+	PathType      string                 `protobuf:"bytes,1,opt,name=path_type,json=pathType,proto3" json:"path_type,omitempty"` // The type of the path
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                       // Error message if any
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPathRequest) Reset() {
-	*x = GetPathRequest{}
-	mi := &file_proto_nexus_proto_msgTypes[22]
+func (x *GetPathTypeResponse) Reset() {
+	*x = GetPathTypeResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPathRequest) String() string {
+func (x *GetPathTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPathRequest) ProtoMessage() {}
+func (*GetPathTypeResponse) ProtoMessage() {}
 
-func (x *GetPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[22]
+func (x *GetPathTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,52 +3379,49 @@ func (x *GetPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPathRequest.ProtoReflect.Descriptor instead.
-func (*GetPathRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetPathTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetPathTypeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetPathRequest) GetPath() string {
+func (x *GetPathTypeResponse) GetPathType() string {
 	if x != nil {
-		return x.Path
+		return x.PathType
 	}
 	return ""
 }
 
-type GetNodeResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*GetNodeResponse_StringValue
-	//	*GetNodeResponse_IntValue
-	//	*GetNodeResponse_FloatValue
-	//	*GetNodeResponse_IndividualFile
-	//	*GetNodeResponse_Directory
-	//	*GetNodeResponse_DatabaseTable
-	//	*GetNodeResponse_EventStream
-	Value         isGetNodeResponse_Value `protobuf_oneof:"value"`
-	ValueType     string                  `protobuf:"bytes,8,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
-	IsEndOfPath   bool                    `protobuf:"varint,9,opt,name=is_end_of_path,json=isEndOfPath,proto3" json:"is_end_of_path,omitempty"`
-	Error         string                  `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"` // Error message if any
+func (x *GetPathTypeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Request message for the cached preview and statistics of a dataset
+type GetPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`        // Path of the dataset in the data Trie
+	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"` // Recompute the preview instead of returning the cached one
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeResponse) Reset() {
-	*x = GetNodeResponse{}
-	mi := &file_proto_nexus_proto_msgTypes[23]
+func (x *GetPreviewRequest) Reset() {
+	*x = GetPreviewRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeResponse) String() string {
+func (x *GetPreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeResponse) ProtoMessage() {}
+func (*GetPreviewRequest) ProtoMessage() {}
 
-func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[23]
+func (x *GetPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,175 +3432,219 @@ func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetNodeResponse) GetValue() isGetNodeResponse_Value {
+func (x *GetPreviewRequest) GetPath() string {
 	if x != nil {
-		return x.Value
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *GetNodeResponse) GetStringValue() *StringValue {
+func (x *GetPreviewRequest) GetRefresh() bool {
 	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_StringValue); ok {
-			return x.StringValue
-		}
+		return x.Refresh
 	}
-	return nil
+	return false
 }
 
-func (x *GetNodeResponse) GetIntValue() *IntValue {
-	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_IntValue); ok {
-			return x.IntValue
-		}
-	}
-	return nil
+// Response message with the first rows and column statistics of a dataset
+type GetPreviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []*ColumnInfo          `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          []*Row                 `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`                                   // First rows of the dataset
+	Stats         []*ColumnStats         `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`                                 // Statistics of each column, in column order
+	RowsScanned   int64                  `protobuf:"varint,4,opt,name=rows_scanned,json=rowsScanned,proto3" json:"rows_scanned,omitempty"` // Number of rows the statistics were computed over
+	Complete      bool                   `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`                          // Whether the statistics cover every row of the dataset
+	ComputedAt    int64                  `protobuf:"varint,6,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`    // Unix time in seconds at which the preview was computed
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeResponse) GetFloatValue() *FloatValue {
+func (x *GetPreviewResponse) Reset() {
+	*x = GetPreviewResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreviewResponse) ProtoMessage() {}
+
+func (x *GetPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[50]
 	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_FloatValue); ok {
-			return x.FloatValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetNodeResponse) GetIndividualFile() *IndividualFile {
+// Deprecated: Use GetPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GetPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPreviewResponse) GetColumns() []*ColumnInfo {
 	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_IndividualFile); ok {
-			return x.IndividualFile
-		}
+		return x.Columns
 	}
 	return nil
 }
 
-func (x *GetNodeResponse) GetDirectory() *Directory {
+func (x *GetPreviewResponse) GetRows() []*Row {
 	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_Directory); ok {
-			return x.Directory
-		}
+		return x.Rows
 	}
 	return nil
 }
 
-func (x *GetNodeResponse) GetDatabaseTable() *DatabaseTable {
+func (x *GetPreviewResponse) GetStats() []*ColumnStats {
 	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_DatabaseTable); ok {
-			return x.DatabaseTable
-		}
+		return x.Stats
 	}
 	return nil
 }
 
-func (x *GetNodeResponse) GetEventStream() *EventStream {
+func (x *GetPreviewResponse) GetRowsScanned() int64 {
 	if x != nil {
-		if x, ok := x.Value.(*GetNodeResponse_EventStream); ok {
-			return x.EventStream
-		}
+		return x.RowsScanned
 	}
-	return nil
+	return 0
 }
 
-func (x *GetNodeResponse) GetValueType() string {
+func (x *GetPreviewResponse) GetComplete() bool {
 	if x != nil {
-		return x.ValueType
+		return x.Complete
 	}
-	return ""
+	return false
 }
 
-func (x *GetNodeResponse) GetIsEndOfPath() bool {
+func (x *GetPreviewResponse) GetComputedAt() int64 {
 	if x != nil {
-		return x.IsEndOfPath
+		return x.ComputedAt
 	}
-	return false
+	return 0
 }
 
-func (x *GetNodeResponse) GetError() string {
+func (x *GetPreviewResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type isGetNodeResponse_Value interface {
-	isGetNodeResponse_Value()
+// Statistics of a single dataset column
+type ColumnStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NullCount        int64                  `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Min              *Cell                  `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"` // Unset if the column only holds nulls
+	Max              *Cell                  `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	DistinctEstimate int64                  `protobuf:"varint,5,opt,name=distinct_estimate,json=distinctEstimate,proto3" json:"distinct_estimate,omitempty"` // Approximate number of distinct non-null values
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-type GetNodeResponse_StringValue struct {
-	StringValue *StringValue `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+func (x *ColumnStats) Reset() {
+	*x = ColumnStats{}
+	mi := &file_proto_nexus_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetNodeResponse_IntValue struct {
-	IntValue *IntValue `protobuf:"bytes,2,opt,name=int_value,json=intValue,proto3,oneof"`
+func (x *ColumnStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GetNodeResponse_FloatValue struct {
-	FloatValue *FloatValue `protobuf:"bytes,3,opt,name=float_value,json=floatValue,proto3,oneof"`
-}
+func (*ColumnStats) ProtoMessage() {}
 
-type GetNodeResponse_IndividualFile struct {
-	IndividualFile *IndividualFile `protobuf:"bytes,4,opt,name=individual_file,json=individualFile,proto3,oneof"`
+func (x *ColumnStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type GetNodeResponse_Directory struct {
-	Directory *Directory `protobuf:"bytes,5,opt,name=directory,proto3,oneof"`
+// Deprecated: Use ColumnStats.ProtoReflect.Descriptor instead.
+func (*ColumnStats) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{51}
 }
 
-type GetNodeResponse_DatabaseTable struct {
-	DatabaseTable *DatabaseTable `protobuf:"bytes,6,opt,name=database_table,json=databaseTable,proto3,oneof"`
+func (x *ColumnStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-type GetNodeResponse_EventStream struct {
-	EventStream *EventStream `protobuf:"bytes,7,opt,name=event_stream,json=eventStream,proto3,oneof"`
+func (x *ColumnStats) GetNullCount() int64 {
+	if x != nil {
+		return x.NullCount
+	}
+	return 0
 }
 
-func (*GetNodeResponse_StringValue) isGetNodeResponse_Value() {}
-
-func (*GetNodeResponse_IntValue) isGetNodeResponse_Value() {}
-
-func (*GetNodeResponse_FloatValue) isGetNodeResponse_Value() {}
-
-func (*GetNodeResponse_IndividualFile) isGetNodeResponse_Value() {}
-
-func (*GetNodeResponse_Directory) isGetNodeResponse_Value() {}
+func (x *ColumnStats) GetMin() *Cell {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
 
-func (*GetNodeResponse_DatabaseTable) isGetNodeResponse_Value() {}
+func (x *ColumnStats) GetMax() *Cell {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
 
-func (*GetNodeResponse_EventStream) isGetNodeResponse_Value() {}
+func (x *ColumnStats) GetDistinctEstimate() int64 {
+	if x != nil {
+		return x.DistinctEstimate
+	}
+	return 0
+}
 
-// Common messages
-type AccessInfo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Info:
-	//
-	//	*AccessInfo_File
-	//	*AccessInfo_Database
-	Info          isAccessInfo_Info `protobuf_oneof:"info"`
+type GetLineageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"` // One of upstream, downstream or both, defaults to both
+	Depth         int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`        // Maximum number of edges followed from path, unlimited if zero
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *AccessInfo) Reset() {
-	*x = AccessInfo{}
-	mi := &file_proto_nexus_proto_msgTypes[24]
+
+func (x *GetLineageRequest) Reset() {
+	*x = GetLineageRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessInfo) String() string {
+func (x *GetLineageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessInfo) ProtoMessage() {}
+func (*GetLineageRequest) ProtoMessage() {}
 
-func (x *AccessInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[24]
+func (x *GetLineageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,75 +3655,56 @@ func (x *AccessInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessInfo.ProtoReflect.Descriptor instead.
-func (*AccessInfo) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetLineageRequest.ProtoReflect.Descriptor instead.
+func (*GetLineageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AccessInfo) GetInfo() isAccessInfo_Info {
+func (x *GetLineageRequest) GetPath() string {
 	if x != nil {
-		return x.Info
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *AccessInfo) GetFile() *FileInfo {
+func (x *GetLineageRequest) GetDirection() string {
 	if x != nil {
-		if x, ok := x.Info.(*AccessInfo_File); ok {
-			return x.File
-		}
+		return x.Direction
 	}
-	return nil
+	return ""
 }
 
-func (x *AccessInfo) GetDatabase() *DatabaseInfo {
+func (x *GetLineageRequest) GetDepth() int32 {
 	if x != nil {
-		if x, ok := x.Info.(*AccessInfo_Database); ok {
-			return x.Database
-		}
+		return x.Depth
 	}
-	return nil
-}
-
-type isAccessInfo_Info interface {
-	isAccessInfo_Info()
-}
-
-type AccessInfo_File struct {
-	File *FileInfo `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
-}
-
-type AccessInfo_Database struct {
-	Database *DatabaseInfo `protobuf:"bytes,2,opt,name=database,proto3,oneof"`
+	return 0
 }
 
-func (*AccessInfo_File) isAccessInfo_Info() {}
-
-func (*AccessInfo_Database) isAccessInfo_Info() {}
-
-type FileInfo struct {
+type GetLineageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filepath      string                 `protobuf:"bytes,1,opt,name=filepath,proto3" json:"filepath,omitempty"`
-	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Nodes         []*LineageNode         `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"` // The requested node first, then the nodes reached from it
+	Edges         []*LineageEdge         `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
-	mi := &file_proto_nexus_proto_msgTypes[25]
+func (x *GetLineageResponse) Reset() {
+	*x = GetLineageResponse{}
+	mi := &file_proto_nexus_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileInfo) String() string {
+func (x *GetLineageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*GetLineageResponse) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[25]
+func (x *GetLineageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1610,48 +3715,58 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetLineageResponse.ProtoReflect.Descriptor instead.
+func (*GetLineageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *FileInfo) GetFilepath() string {
+func (x *GetLineageResponse) GetNodes() []*LineageNode {
 	if x != nil {
-		return x.Filepath
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *FileInfo) GetFormat() string {
+func (x *GetLineageResponse) GetEdges() []*LineageEdge {
 	if x != nil {
-		return x.Format
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetLineageResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type DatabaseInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ConnectionString string                 `protobuf:"bytes,1,opt,name=connection_string,json=connectionString,proto3" json:"connection_string,omitempty"`
-	Table            string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type LineageNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`        // Value type of the node, empty if missing
+	Depth         int32                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`     // Number of edges from the requested node, negative for upstream nodes
+	Missing       bool                   `protobuf:"varint,4,opt,name=missing,proto3" json:"missing,omitempty"` // The path is declared as a dependency but doesn't exist
+	Health        *HealthStatus          `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DatabaseInfo) Reset() {
-	*x = DatabaseInfo{}
-	mi := &file_proto_nexus_proto_msgTypes[26]
+func (x *LineageNode) Reset() {
+	*x = LineageNode{}
+	mi := &file_proto_nexus_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DatabaseInfo) String() string {
+func (x *LineageNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DatabaseInfo) ProtoMessage() {}
+func (*LineageNode) ProtoMessage() {}
 
-func (x *DatabaseInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[26]
+func (x *LineageNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,48 +3777,70 @@ func (x *DatabaseInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DatabaseInfo.ProtoReflect.Descriptor instead.
-func (*DatabaseInfo) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use LineageNode.ProtoReflect.Descriptor instead.
+func (*LineageNode) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *DatabaseInfo) GetConnectionString() string {
+func (x *LineageNode) GetPath() string {
 	if x != nil {
-		return x.ConnectionString
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DatabaseInfo) GetTable() string {
+func (x *LineageNode) GetType() string {
 	if x != nil {
-		return x.Table
+		return x.Type
 	}
 	return ""
 }
 
-// Request message for listing children
-type GetChildrenRequest struct {
+func (x *LineageNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *LineageNode) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+func (x *LineageNode) GetHealth() *HealthStatus {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// A dependency of the downstream path on the upstream path
+type LineageEdge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Path in the data Trie
+	Upstream      string                 `protobuf:"bytes,1,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	Downstream    string                 `protobuf:"bytes,2,opt,name=downstream,proto3" json:"downstream,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChildrenRequest) Reset() {
-	*x = GetChildrenRequest{}
-	mi := &file_proto_nexus_proto_msgTypes[27]
+func (x *LineageEdge) Reset() {
+	*x = LineageEdge{}
+	mi := &file_proto_nexus_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChildrenRequest) String() string {
+func (x *LineageEdge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChildrenRequest) ProtoMessage() {}
+func (*LineageEdge) ProtoMessage() {}
 
-func (x *GetChildrenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[27]
+func (x *LineageEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,41 +3851,55 @@ func (x *GetChildrenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChildrenRequest.ProtoReflect.Descriptor instead.
-func (*GetChildrenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use LineageEdge.ProtoReflect.Descriptor instead.
+func (*LineageEdge) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetChildrenRequest) GetPath() string {
+func (x *LineageEdge) GetUpstream() string {
 	if x != nil {
-		return x.Path
+		return x.Upstream
 	}
 	return ""
 }
 
-// Response message for listing children
-type GetChildrenResponse struct {
+func (x *LineageEdge) GetDownstream() string {
+	if x != nil {
+		return x.Downstream
+	}
+	return ""
+}
+
+// Request to consume a registered event stream through the server
+type RelayEventStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Children      []*ChildInfo           `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty"` // List of child paths and their types
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                                                                   // Path of the event stream
+	Partitions    []int32                `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`                                                               // Partitions to consume, all of the topic's partitions if empty
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                                                                                 // newest or oldest, newest if empty
+	Since         int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`                                                                                // Start at the first message produced at or after this Unix time in milliseconds, if set
+	LastN         int64                  `protobuf:"varint,5,opt,name=last_n,json=lastN,proto3" json:"last_n,omitempty"`                                                                   // Start this many messages before the end of each partition, if set
+	Offsets       map[int32]int64        `protobuf:"bytes,6,rep,name=offsets,proto3" json:"offsets,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Offsets to start partitions at, by partition
+	GroupId       string                 `protobuf:"bytes,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`                                                              // Consumer group the server joins, the stream's default group if empty
+	Standalone    bool                   `protobuf:"varint,8,opt,name=standalone,proto3" json:"standalone,omitempty"`                                                                      // Don't join the stream's default group
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChildrenResponse) Reset() {
-	*x = GetChildrenResponse{}
-	mi := &file_proto_nexus_proto_msgTypes[28]
+func (x *RelayEventStreamRequest) Reset() {
+	*x = RelayEventStreamRequest{}
+	mi := &file_proto_nexus_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChildrenResponse) String() string {
+func (x *RelayEventStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChildrenResponse) ProtoMessage() {}
+func (*RelayEventStreamRequest) ProtoMessage() {}
 
-func (x *GetChildrenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[28]
+func (x *RelayEventStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,43 +3910,98 @@ func (x *GetChildrenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChildrenResponse.ProtoReflect.Descriptor instead.
-func (*GetChildrenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RelayEventStreamRequest.ProtoReflect.Descriptor instead.
+func (*RelayEventStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetChildrenResponse) GetChildren() []*ChildInfo {
+func (x *RelayEventStreamRequest) GetPath() string {
 	if x != nil {
-		return x.Children
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RelayEventStreamRequest) GetPartitions() []int32 {
+	if x != nil {
+		return x.Partitions
 	}
 	return nil
 }
 
-// New message to hold child information
-type ChildInfo struct {
+func (x *RelayEventStreamRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *RelayEventStreamRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *RelayEventStreamRequest) GetLastN() int64 {
+	if x != nil {
+		return x.LastN
+	}
+	return 0
+}
+
+func (x *RelayEventStreamRequest) GetOffsets() map[int32]int64 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *RelayEventStreamRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RelayEventStreamRequest) GetStandalone() bool {
+	if x != nil {
+		return x.Standalone
+	}
+	return false
+}
+
+// A message consumed from an event stream, or an error consuming it. The first message of a
+// relay is empty once the server has subscribed, or holds the error that kept it from subscribing.
+type RelayMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                // Name of the child
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                // Type of the child
-	NumChildren   int32                  `protobuf:"varint,3,opt,name=numChildren,proto3" json:"numChildren,omitempty"` // Number of children
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Partition     int32                  `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key           []byte                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Headers       []*MessageHeader       `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix time in milliseconds the message was produced
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`          // Set instead of the message fields when consuming failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChildInfo) Reset() {
-	*x = ChildInfo{}
-	mi := &file_proto_nexus_proto_msgTypes[29]
+func (x *RelayMessage) Reset() {
+	*x = RelayMessage{}
+	mi := &file_proto_nexus_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChildInfo) String() string {
+func (x *RelayMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChildInfo) ProtoMessage() {}
+func (*RelayMessage) ProtoMessage() {}
 
-func (x *ChildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[29]
+func (x *RelayMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,55 +4012,91 @@ func (x *ChildInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChildInfo.ProtoReflect.Descriptor instead.
-func (*ChildInfo) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RelayMessage.ProtoReflect.Descriptor instead.
+func (*RelayMessage) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ChildInfo) GetName() string {
+func (x *RelayMessage) GetTopic() string {
 	if x != nil {
-		return x.Name
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *ChildInfo) GetType() string {
+func (x *RelayMessage) GetPartition() int32 {
 	if x != nil {
-		return x.Type
+		return x.Partition
 	}
-	return ""
+	return 0
 }
 
-func (x *ChildInfo) GetNumChildren() int32 {
+func (x *RelayMessage) GetOffset() int64 {
 	if x != nil {
-		return x.NumChildren
+		return x.Offset
 	}
 	return 0
 }
 
-type GetPathTypeResponse struct {
+func (x *RelayMessage) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *RelayMessage) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *RelayMessage) GetHeaders() []*MessageHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RelayMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RelayMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A key and value attached to a message
+type MessageHeader struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PathType      string                 `protobuf:"bytes,1,opt,name=path_type,json=pathType,proto3" json:"path_type,omitempty"` // The type of the path
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`                       // Error message if any
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPathTypeResponse) Reset() {
-	*x = GetPathTypeResponse{}
-	mi := &file_proto_nexus_proto_msgTypes[30]
+func (x *MessageHeader) Reset() {
+	*x = MessageHeader{}
+	mi := &file_proto_nexus_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPathTypeResponse) String() string {
+func (x *MessageHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPathTypeResponse) ProtoMessage() {}
+func (*MessageHeader) ProtoMessage() {}
 
-func (x *GetPathTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_nexus_proto_msgTypes[30]
+func (x *MessageHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nexus_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,23 +4107,23 @@ func (x *GetPathTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPathTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetPathTypeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_nexus_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use MessageHeader.ProtoReflect.Descriptor instead.
+func (*MessageHeader) Descriptor() ([]byte, []int) {
+	return file_proto_nexus_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetPathTypeResponse) GetPathType() string {
+func (x *MessageHeader) GetKey() string {
 	if x != nil {
-		return x.PathType
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GetPathTypeResponse) GetError() string {
+func (x *MessageHeader) GetValue() []byte {
 	if x != nil {
-		return x.Error
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
 var File_proto_nexus_proto protoreflect.FileDescriptor
@@ -1934,194 +4176,544 @@ var file_proto_nexus_proto_rawDesc = string([]byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14,
 	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x37,
-	0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x78,
-	0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e,
-	0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48,
-	0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x0b, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x4e, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x22, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x22, 0x47, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x02,
+	0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x2e, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x34, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x64, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x6c, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x22, 0x48, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdb, 0x03, 0x0a, 0x0b, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xc7, 0x01, 0x0a, 0x07, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75,
-	0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
-	0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75,
-	0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75,
-	0x73, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x09, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x22, 0x6d, 0x0a, 0x0e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x61, 0x66,
+	0x6b, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x54, 0x4c, 0x53, 0x52, 0x03,
+	0x74, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x61, 0x73, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53,
+	0x41, 0x53, 0x4c, 0x52, 0x04, 0x73, 0x61, 0x73, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x53,
+	0x65, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x4c,
+	0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x54, 0x4c, 0x53, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b,
+	0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53,
+	0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x50, 0x0a, 0x09, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x53, 0x41, 0x53, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x63, 0x68, 0x61, 0x6e,
+	0x69, 0x73, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x63, 0x68, 0x61,
+	0x6e, 0x69, 0x73, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x22, 0x3a, 0x0a, 0x0e, 0x4c,
+	0x61, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
+	0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
+	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x22, 0x80, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c,
 	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70,
 	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21,
 	0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x22, 0x6e, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b,
-	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x23, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x20, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x1b, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x22, 0xff, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e,
-	0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x2e, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x34, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64,
-	0x75, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61,
-	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64,
-	0x75, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78,
-	0x75, 0x73, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x45, 0x6e, 0x64, 0x4f,
-	0x66, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x6e, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x25, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
-	0x78, 0x75, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x06, 0x0a, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x3e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x22, 0x51, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x22, 0xf4, 0x02, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x0b,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x0b,
+	0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd9, 0x02,
+	0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x29,
+	0x0a, 0x10, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x79, 0x0a, 0x05, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x35, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0x74, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28,
+	0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x20, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x22, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x97,
+	0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x2e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x85,
+	0x05, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0b,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x69,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x40, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x46, 0x69, 0x6c,
+	0x65, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00,
+	0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x6f, 0x66, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x73, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x07, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x68, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x6e, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x22, 0x3e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x51, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0xcb, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x12,
+	0x49, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x66,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x46, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1e,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x2e, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x34, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x28, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x97, 0x01,
+	0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68,
 	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
 	0x68, 0x22, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c,
 	0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x78,
 	0x75, 0x73, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x55, 0x0a, 0x09, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
-	0x75, 0x6d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x48, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xad, 0x05, 0x0a, 0x0c, 0x4e, 0x65, 0x78, 0x75,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x75, 0x6d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2b,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x81, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6e, 0x65, 0x78,
+	0x75, 0x73, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x28, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x6f,
+	0x77, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xab, 0x01, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x1d,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x2b, 0x0a,
+	0x11, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x63, 0x74, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x7e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x4c,
+	0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x65,
+	0x61, 0x67, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12,
+	0x2b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x49, 0x0a, 0x0b,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x45, 0x64, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x77,
+	0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xce, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x12, 0x45, 0x0a, 0x07, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe6, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x37, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xe1, 0x08, 0x0a, 0x0c, 0x4e,
+	0x65, 0x78, 0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
 	0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x56, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x52, 0x65,
 	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75,
-	0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x2e, 0x6e,
-	0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17,
-	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x12, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x65,
-	0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x11, 0x5a, 0x0f, 0x6e, 0x65, 0x78, 0x75, 0x73,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x62, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1b, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61,
+	0x67, 0x65, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x6e,
+	0x65, 0x78, 0x75, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x15, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x19,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x41, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x2e, 0x6e, 0x65,
+	0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x42, 0x11,
+	0x5a, 0x0f, 0x6e, 0x65, 0x78, 0x75, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -2136,7 +4728,7 @@ func file_proto_nexus_proto_rawDescGZIP() []byte {
 	return file_proto_nexus_proto_rawDescData
 }
 
-var file_proto_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_proto_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_proto_nexus_proto_goTypes = []any{
 	(*RegisterEventStreamRequest)(nil),    // 0: nexus.RegisterEventStreamRequest
 	(*RegisterEventStreamResponse)(nil),   // 1: nexus.RegisterEventStreamResponse
@@ -2146,74 +4738,146 @@ var file_proto_nexus_proto_goTypes = []any{
 	(*RegisterDirectoryResponse)(nil),     // 5: nexus.RegisterDirectoryResponse
 	(*RegisterDatabaseTableRequest)(nil),  // 6: nexus.RegisterDatabaseTableRequest
 	(*RegisterDatabaseTableResponse)(nil), // 7: nexus.RegisterDatabaseTableResponse
-	(*StoreValueRequest)(nil),             // 8: nexus.StoreValueRequest
-	(*StoreValueResponse)(nil),            // 9: nexus.StoreValueResponse
-	(*DeletePathRequest)(nil),             // 10: nexus.DeletePathRequest
-	(*DeletePathResponse)(nil),            // 11: nexus.DeletePathResponse
-	(*EventStream)(nil),                   // 12: nexus.EventStream
-	(*Dataset)(nil),                       // 13: nexus.Dataset
-	(*IndividualFile)(nil),                // 14: nexus.IndividualFile
-	(*Directory)(nil),                     // 15: nexus.Directory
-	(*DatabaseTable)(nil),                 // 16: nexus.DatabaseTable
-	(*StringValue)(nil),                   // 17: nexus.StringValue
-	(*IntValue)(nil),                      // 18: nexus.IntValue
-	(*FloatValue)(nil),                    // 19: nexus.FloatValue
-	(*SubscribeRequest)(nil),              // 20: nexus.SubscribeRequest
-	(*Event)(nil),                         // 21: nexus.Event
-	(*GetPathRequest)(nil),                // 22: nexus.GetPathRequest
-	(*GetNodeResponse)(nil),               // 23: nexus.GetNodeResponse
-	(*AccessInfo)(nil),                    // 24: nexus.AccessInfo
-	(*FileInfo)(nil),                      // 25: nexus.FileInfo
-	(*DatabaseInfo)(nil),                  // 26: nexus.DatabaseInfo
-	(*GetChildrenRequest)(nil),            // 27: nexus.GetChildrenRequest
-	(*GetChildrenResponse)(nil),           // 28: nexus.GetChildrenResponse
-	(*ChildInfo)(nil),                     // 29: nexus.ChildInfo
-	(*GetPathTypeResponse)(nil),           // 30: nexus.GetPathTypeResponse
+	(*RegisterQueryRequest)(nil),          // 8: nexus.RegisterQueryRequest
+	(*RegisterQueryResponse)(nil),         // 9: nexus.RegisterQueryResponse
+	(*StoreValueRequest)(nil),             // 10: nexus.StoreValueRequest
+	(*StoreValueResponse)(nil),            // 11: nexus.StoreValueResponse
+	(*DeletePathRequest)(nil),             // 12: nexus.DeletePathRequest
+	(*DeletePathResponse)(nil),            // 13: nexus.DeletePathResponse
+	(*DeclareLineageRequest)(nil),         // 14: nexus.DeclareLineageRequest
+	(*DeclareLineageResponse)(nil),        // 15: nexus.DeclareLineageResponse
+	(*EventStream)(nil),                   // 16: nexus.EventStream
+	(*KafkaTLS)(nil),                      // 17: nexus.KafkaTLS
+	(*KafkaSASL)(nil),                     // 18: nexus.KafkaSASL
+	(*LastValueCache)(nil),                // 19: nexus.LastValueCache
+	(*Dataset)(nil),                       // 20: nexus.Dataset
+	(*IndividualFile)(nil),                // 21: nexus.IndividualFile
+	(*Directory)(nil),                     // 22: nexus.Directory
+	(*Fingerprint)(nil),                   // 23: nexus.Fingerprint
+	(*DatabaseTable)(nil),                 // 24: nexus.DatabaseTable
+	(*Query)(nil),                         // 25: nexus.Query
+	(*QueryParameter)(nil),                // 26: nexus.QueryParameter
+	(*StringValue)(nil),                   // 27: nexus.StringValue
+	(*IntValue)(nil),                      // 28: nexus.IntValue
+	(*FloatValue)(nil),                    // 29: nexus.FloatValue
+	(*BoolValue)(nil),                     // 30: nexus.BoolValue
+	(*SubscribeRequest)(nil),              // 31: nexus.SubscribeRequest
+	(*Event)(nil),                         // 32: nexus.Event
+	(*GetPathRequest)(nil),                // 33: nexus.GetPathRequest
+	(*GetNodeResponse)(nil),               // 34: nexus.GetNodeResponse
+	(*HealthStatus)(nil),                  // 35: nexus.HealthStatus
+	(*AccessInfo)(nil),                    // 36: nexus.AccessInfo
+	(*FileInfo)(nil),                      // 37: nexus.FileInfo
+	(*DatabaseInfo)(nil),                  // 38: nexus.DatabaseInfo
+	(*ReadDatasetRequest)(nil),            // 39: nexus.ReadDatasetRequest
+	(*Filter)(nil),                        // 40: nexus.Filter
+	(*ReadDatasetResponse)(nil),           // 41: nexus.ReadDatasetResponse
+	(*ColumnInfo)(nil),                    // 42: nexus.ColumnInfo
+	(*Row)(nil),                           // 43: nexus.Row
+	(*Cell)(nil),                          // 44: nexus.Cell
+	(*GetChildrenRequest)(nil),            // 45: nexus.GetChildrenRequest
+	(*GetChildrenResponse)(nil),           // 46: nexus.GetChildrenResponse
+	(*ChildInfo)(nil),                     // 47: nexus.ChildInfo
+	(*GetPathTypeResponse)(nil),           // 48: nexus.GetPathTypeResponse
+	(*GetPreviewRequest)(nil),             // 49: nexus.GetPreviewRequest
+	(*GetPreviewResponse)(nil),            // 50: nexus.GetPreviewResponse
+	(*ColumnStats)(nil),                   // 51: nexus.ColumnStats
+	(*GetLineageRequest)(nil),             // 52: nexus.GetLineageRequest
+	(*GetLineageResponse)(nil),            // 53: nexus.GetLineageResponse
+	(*LineageNode)(nil),                   // 54: nexus.LineageNode
+	(*LineageEdge)(nil),                   // 55: nexus.LineageEdge
+	(*RelayEventStreamRequest)(nil),       // 56: nexus.RelayEventStreamRequest
+	(*RelayMessage)(nil),                  // 57: nexus.RelayMessage
+	(*MessageHeader)(nil),                 // 58: nexus.MessageHeader
+	nil,                                   // 59: nexus.ReadDatasetRequest.ParametersEntry
+	nil,                                   // 60: nexus.RelayEventStreamRequest.OffsetsEntry
 }
 var file_proto_nexus_proto_depIdxs = []int32{
-	12, // 0: nexus.RegisterEventStreamRequest.event_stream:type_name -> nexus.EventStream
-	14, // 1: nexus.RegisterFileRequest.individual_file:type_name -> nexus.IndividualFile
-	15, // 2: nexus.RegisterDirectoryRequest.directory:type_name -> nexus.Directory
-	16, // 3: nexus.RegisterDatabaseTableRequest.database_table:type_name -> nexus.DatabaseTable
-	17, // 4: nexus.StoreValueRequest.string_value:type_name -> nexus.StringValue
-	18, // 5: nexus.StoreValueRequest.int_value:type_name -> nexus.IntValue
-	19, // 6: nexus.StoreValueRequest.float_value:type_name -> nexus.FloatValue
-	14, // 7: nexus.Dataset.individual_file:type_name -> nexus.IndividualFile
-	15, // 8: nexus.Dataset.directory:type_name -> nexus.Directory
-	16, // 9: nexus.Dataset.database_table:type_name -> nexus.DatabaseTable
-	17, // 10: nexus.GetNodeResponse.string_value:type_name -> nexus.StringValue
-	18, // 11: nexus.GetNodeResponse.int_value:type_name -> nexus.IntValue
-	19, // 12: nexus.GetNodeResponse.float_value:type_name -> nexus.FloatValue
-	14, // 13: nexus.GetNodeResponse.individual_file:type_name -> nexus.IndividualFile
-	15, // 14: nexus.GetNodeResponse.directory:type_name -> nexus.Directory
-	16, // 15: nexus.GetNodeResponse.database_table:type_name -> nexus.DatabaseTable
-	12, // 16: nexus.GetNodeResponse.event_stream:type_name -> nexus.EventStream
-	25, // 17: nexus.AccessInfo.file:type_name -> nexus.FileInfo
-	26, // 18: nexus.AccessInfo.database:type_name -> nexus.DatabaseInfo
-	29, // 19: nexus.GetChildrenResponse.children:type_name -> nexus.ChildInfo
-	0,  // 20: nexus.NexusService.RegisterEventStream:input_type -> nexus.RegisterEventStreamRequest
-	2,  // 21: nexus.NexusService.RegisterFile:input_type -> nexus.RegisterFileRequest
-	4,  // 22: nexus.NexusService.RegisterDirectory:input_type -> nexus.RegisterDirectoryRequest
-	6,  // 23: nexus.NexusService.RegisterDatabaseTable:input_type -> nexus.RegisterDatabaseTableRequest
-	8,  // 24: nexus.NexusService.StoreValue:input_type -> nexus.StoreValueRequest
-	10, // 25: nexus.NexusService.DeletePath:input_type -> nexus.DeletePathRequest
-	20, // 26: nexus.NexusService.Subscribe:input_type -> nexus.SubscribeRequest
-	22, // 27: nexus.NexusService.GetNode:input_type -> nexus.GetPathRequest
-	27, // 28: nexus.NexusService.GetChildren:input_type -> nexus.GetChildrenRequest
-	1,  // 29: nexus.NexusService.RegisterEventStream:output_type -> nexus.RegisterEventStreamResponse
-	3,  // 30: nexus.NexusService.RegisterFile:output_type -> nexus.RegisterFileResponse
-	5,  // 31: nexus.NexusService.RegisterDirectory:output_type -> nexus.RegisterDirectoryResponse
-	7,  // 32: nexus.NexusService.RegisterDatabaseTable:output_type -> nexus.RegisterDatabaseTableResponse
-	9,  // 33: nexus.NexusService.StoreValue:output_type -> nexus.StoreValueResponse
-	11, // 34: nexus.NexusService.DeletePath:output_type -> nexus.DeletePathResponse
-	21, // 35: nexus.NexusService.Subscribe:output_type -> nexus.Event
-	23, // 36: nexus.NexusService.GetNode:output_type -> nexus.GetNodeResponse
-	28, // 37: nexus.NexusService.GetChildren:output_type -> nexus.GetChildrenResponse
-	29, // [29:38] is the sub-list for method output_type
-	20, // [20:29] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	16, // 0: nexus.RegisterEventStreamRequest.event_stream:type_name -> nexus.EventStream
+	21, // 1: nexus.RegisterFileRequest.individual_file:type_name -> nexus.IndividualFile
+	22, // 2: nexus.RegisterDirectoryRequest.directory:type_name -> nexus.Directory
+	24, // 3: nexus.RegisterDatabaseTableRequest.database_table:type_name -> nexus.DatabaseTable
+	25, // 4: nexus.RegisterQueryRequest.query:type_name -> nexus.Query
+	27, // 5: nexus.StoreValueRequest.string_value:type_name -> nexus.StringValue
+	28, // 6: nexus.StoreValueRequest.int_value:type_name -> nexus.IntValue
+	29, // 7: nexus.StoreValueRequest.float_value:type_name -> nexus.FloatValue
+	30, // 8: nexus.StoreValueRequest.bool_value:type_name -> nexus.BoolValue
+	17, // 9: nexus.EventStream.tls:type_name -> nexus.KafkaTLS
+	18, // 10: nexus.EventStream.sasl:type_name -> nexus.KafkaSASL
+	19, // 11: nexus.EventStream.last_value:type_name -> nexus.LastValueCache
+	21, // 12: nexus.Dataset.individual_file:type_name -> nexus.IndividualFile
+	22, // 13: nexus.Dataset.directory:type_name -> nexus.Directory
+	24, // 14: nexus.Dataset.database_table:type_name -> nexus.DatabaseTable
+	23, // 15: nexus.IndividualFile.fingerprint:type_name -> nexus.Fingerprint
+	23, // 16: nexus.Directory.fingerprint:type_name -> nexus.Fingerprint
+	40, // 17: nexus.DatabaseTable.filters:type_name -> nexus.Filter
+	26, // 18: nexus.Query.parameters:type_name -> nexus.QueryParameter
+	23, // 19: nexus.Event.fingerprint:type_name -> nexus.Fingerprint
+	27, // 20: nexus.GetNodeResponse.string_value:type_name -> nexus.StringValue
+	28, // 21: nexus.GetNodeResponse.int_value:type_name -> nexus.IntValue
+	29, // 22: nexus.GetNodeResponse.float_value:type_name -> nexus.FloatValue
+	21, // 23: nexus.GetNodeResponse.individual_file:type_name -> nexus.IndividualFile
+	22, // 24: nexus.GetNodeResponse.directory:type_name -> nexus.Directory
+	24, // 25: nexus.GetNodeResponse.database_table:type_name -> nexus.DatabaseTable
+	16, // 26: nexus.GetNodeResponse.event_stream:type_name -> nexus.EventStream
+	25, // 27: nexus.GetNodeResponse.query:type_name -> nexus.Query
+	30, // 28: nexus.GetNodeResponse.bool_value:type_name -> nexus.BoolValue
+	35, // 29: nexus.GetNodeResponse.health:type_name -> nexus.HealthStatus
+	37, // 30: nexus.AccessInfo.file:type_name -> nexus.FileInfo
+	38, // 31: nexus.AccessInfo.database:type_name -> nexus.DatabaseInfo
+	40, // 32: nexus.ReadDatasetRequest.filters:type_name -> nexus.Filter
+	59, // 33: nexus.ReadDatasetRequest.parameters:type_name -> nexus.ReadDatasetRequest.ParametersEntry
+	42, // 34: nexus.ReadDatasetResponse.columns:type_name -> nexus.ColumnInfo
+	43, // 35: nexus.ReadDatasetResponse.rows:type_name -> nexus.Row
+	23, // 36: nexus.ReadDatasetResponse.fingerprint:type_name -> nexus.Fingerprint
+	44, // 37: nexus.Row.cells:type_name -> nexus.Cell
+	47, // 38: nexus.GetChildrenResponse.children:type_name -> nexus.ChildInfo
+	35, // 39: nexus.ChildInfo.health:type_name -> nexus.HealthStatus
+	42, // 40: nexus.GetPreviewResponse.columns:type_name -> nexus.ColumnInfo
+	43, // 41: nexus.GetPreviewResponse.rows:type_name -> nexus.Row
+	51, // 42: nexus.GetPreviewResponse.stats:type_name -> nexus.ColumnStats
+	44, // 43: nexus.ColumnStats.min:type_name -> nexus.Cell
+	44, // 44: nexus.ColumnStats.max:type_name -> nexus.Cell
+	54, // 45: nexus.GetLineageResponse.nodes:type_name -> nexus.LineageNode
+	55, // 46: nexus.GetLineageResponse.edges:type_name -> nexus.LineageEdge
+	35, // 47: nexus.LineageNode.health:type_name -> nexus.HealthStatus
+	60, // 48: nexus.RelayEventStreamRequest.offsets:type_name -> nexus.RelayEventStreamRequest.OffsetsEntry
+	58, // 49: nexus.RelayMessage.headers:type_name -> nexus.MessageHeader
+	0,  // 50: nexus.NexusService.RegisterEventStream:input_type -> nexus.RegisterEventStreamRequest
+	2,  // 51: nexus.NexusService.RegisterFile:input_type -> nexus.RegisterFileRequest
+	4,  // 52: nexus.NexusService.RegisterDirectory:input_type -> nexus.RegisterDirectoryRequest
+	6,  // 53: nexus.NexusService.RegisterDatabaseTable:input_type -> nexus.RegisterDatabaseTableRequest
+	8,  // 54: nexus.NexusService.RegisterQuery:input_type -> nexus.RegisterQueryRequest
+	10, // 55: nexus.NexusService.StoreValue:input_type -> nexus.StoreValueRequest
+	12, // 56: nexus.NexusService.DeletePath:input_type -> nexus.DeletePathRequest
+	14, // 57: nexus.NexusService.DeclareLineage:input_type -> nexus.DeclareLineageRequest
+	31, // 58: nexus.NexusService.Subscribe:input_type -> nexus.SubscribeRequest
+	33, // 59: nexus.NexusService.GetNode:input_type -> nexus.GetPathRequest
+	45, // 60: nexus.NexusService.GetChildren:input_type -> nexus.GetChildrenRequest
+	39, // 61: nexus.NexusService.ReadDataset:input_type -> nexus.ReadDatasetRequest
+	49, // 62: nexus.NexusService.GetPreview:input_type -> nexus.GetPreviewRequest
+	52, // 63: nexus.NexusService.GetLineage:input_type -> nexus.GetLineageRequest
+	56, // 64: nexus.NexusService.RelayEventStream:input_type -> nexus.RelayEventStreamRequest
+	1,  // 65: nexus.NexusService.RegisterEventStream:output_type -> nexus.RegisterEventStreamResponse
+	3,  // 66: nexus.NexusService.RegisterFile:output_type -> nexus.RegisterFileResponse
+	5,  // 67: nexus.NexusService.RegisterDirectory:output_type -> nexus.RegisterDirectoryResponse
+	7,  // 68: nexus.NexusService.RegisterDatabaseTable:output_type -> nexus.RegisterDatabaseTableResponse
+	9,  // 69: nexus.NexusService.RegisterQuery:output_type -> nexus.RegisterQueryResponse
+	11, // 70: nexus.NexusService.StoreValue:output_type -> nexus.StoreValueResponse
+	13, // 71: nexus.NexusService.DeletePath:output_type -> nexus.DeletePathResponse
+	15, // 72: nexus.NexusService.DeclareLineage:output_type -> nexus.DeclareLineageResponse
+	32, // 73: nexus.NexusService.Subscribe:output_type -> nexus.Event
+	34, // 74: nexus.NexusService.GetNode:output_type -> nexus.GetNodeResponse
+	46, // 75: nexus.NexusService.GetChildren:output_type -> nexus.GetChildrenResponse
+	41, // 76: nexus.NexusService.ReadDataset:output_type -> nexus.ReadDatasetResponse
+	50, // 77: nexus.NexusService.GetPreview:output_type -> nexus.GetPreviewResponse
+	53, // 78: nexus.NexusService.GetLineage:output_type -> nexus.GetLineageResponse
+	57, // 79: nexus.NexusService.RelayEventStream:output_type -> nexus.RelayMessage
+	65, // [65:80] is the sub-list for method output_type
+	50, // [50:65] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_proto_nexus_proto_init() }
@@ -2221,17 +4885,19 @@ func file_proto_nexus_proto_init() {
 	if File_proto_nexus_proto != nil {
 		return
 	}
-	file_proto_nexus_proto_msgTypes[8].OneofWrappers = []any{
+	file_proto_nexus_proto_msgTypes[10].OneofWrappers = []any{
 		(*StoreValueRequest_StringValue)(nil),
 		(*StoreValueRequest_IntValue)(nil),
 		(*StoreValueRequest_FloatValue)(nil),
+		(*StoreValueRequest_BoolValue)(nil),
 	}
-	file_proto_nexus_proto_msgTypes[13].OneofWrappers = []any{
+	file_proto_nexus_proto_msgTypes[20].OneofWrappers = []any{
 		(*Dataset_IndividualFile)(nil),
 		(*Dataset_Directory)(nil),
 		(*Dataset_DatabaseTable)(nil),
 	}
-	file_proto_nexus_proto_msgTypes[23].OneofWrappers = []any{
+	file_proto_nexus_proto_msgTypes[26].OneofWrappers = []any{}
+	file_proto_nexus_proto_msgTypes[34].OneofWrappers = []any{
 		(*GetNodeResponse_StringValue)(nil),
 		(*GetNodeResponse_IntValue)(nil),
 		(*GetNodeResponse_FloatValue)(nil),
@@ -2239,18 +4905,26 @@ func file_proto_nexus_proto_init() {
 		(*GetNodeResponse_Directory)(nil),
 		(*GetNodeResponse_DatabaseTable)(nil),
 		(*GetNodeResponse_EventStream)(nil),
+		(*GetNodeResponse_Query)(nil),
+		(*GetNodeResponse_BoolValue)(nil),
 	}
-	file_proto_nexus_proto_msgTypes[24].OneofWrappers = []any{
+	file_proto_nexus_proto_msgTypes[36].OneofWrappers = []any{
 		(*AccessInfo_File)(nil),
 		(*AccessInfo_Database)(nil),
 	}
+	file_proto_nexus_proto_msgTypes[44].OneofWrappers = []any{
+		(*Cell_StringValue)(nil),
+		(*Cell_IntValue)(nil),
+		(*Cell_FloatValue)(nil),
+		(*Cell_BoolValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_nexus_proto_rawDesc), len(file_proto_nexus_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,11 +23,17 @@ const (
 	NexusService_RegisterFile_FullMethodName          = "/nexus.NexusService/RegisterFile"
 	NexusService_RegisterDirectory_FullMethodName     = "/nexus.NexusService/RegisterDirectory"
 	NexusService_RegisterDatabaseTable_FullMethodName = "/nexus.NexusService/RegisterDatabaseTable"
+	NexusService_RegisterQuery_FullMethodName         = "/nexus.NexusService/RegisterQuery"
 	NexusService_StoreValue_FullMethodName            = "/nexus.NexusService/StoreValue"
 	NexusService_DeletePath_FullMethodName            = "/nexus.NexusService/DeletePath"
+	NexusService_DeclareLineage_FullMethodName        = "/nexus.NexusService/DeclareLineage"
 	NexusService_Subscribe_FullMethodName             = "/nexus.NexusService/Subscribe"
 	NexusService_GetNode_FullMethodName               = "/nexus.NexusService/GetNode"
 	NexusService_GetChildren_FullMethodName           = "/nexus.NexusService/GetChildren"
+	NexusService_ReadDataset_FullMethodName           = "/nexus.NexusService/ReadDataset"
+	NexusService_GetPreview_FullMethodName            = "/nexus.NexusService/GetPreview"
+	NexusService_GetLineage_FullMethodName            = "/nexus.NexusService/GetLineage"
+	NexusService_RelayEventStream_FullMethodName      = "/nexus.NexusService/RelayEventStream"
 )
 
 // NexusServiceClient is the client API for NexusService service.
@@ -41,13 +47,22 @@ type NexusServiceClient interface {
 	RegisterFile(ctx context.Context, in *RegisterFileRequest, opts ...grpc.CallOption) (*RegisterFileResponse, error)
 	RegisterDirectory(ctx context.Context, in *RegisterDirectoryRequest, opts ...grpc.CallOption) (*RegisterDirectoryResponse, error)
 	RegisterDatabaseTable(ctx context.Context, in *RegisterDatabaseTableRequest, opts ...grpc.CallOption) (*RegisterDatabaseTableResponse, error)
+	RegisterQuery(ctx context.Context, in *RegisterQueryRequest, opts ...grpc.CallOption) (*RegisterQueryResponse, error)
 	StoreValue(ctx context.Context, in *StoreValueRequest, opts ...grpc.CallOption) (*StoreValueResponse, error)
 	DeletePath(ctx context.Context, in *DeletePathRequest, opts ...grpc.CallOption) (*DeletePathResponse, error)
+	DeclareLineage(ctx context.Context, in *DeclareLineageRequest, opts ...grpc.CallOption) (*DeclareLineageResponse, error)
 	// Consumer endpoints
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
 	GetNode(ctx context.Context, in *GetPathRequest, opts ...grpc.CallOption) (*GetNodeResponse, error)
 	// Add this to the NexusService
 	GetChildren(ctx context.Context, in *GetChildrenRequest, opts ...grpc.CallOption) (*GetChildrenResponse, error)
+	// Dataset access endpoints
+	ReadDataset(ctx context.Context, in *ReadDatasetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadDatasetResponse], error)
+	GetPreview(ctx context.Context, in *GetPreviewRequest, opts ...grpc.CallOption) (*GetPreviewResponse, error)
+	// Lineage endpoints
+	GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*GetLineageResponse, error)
+	// Event stream relay endpoints
+	RelayEventStream(ctx context.Context, in *RelayEventStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RelayMessage], error)
 }
 
 type nexusServiceClient struct {
@@ -98,6 +113,16 @@ func (c *nexusServiceClient) RegisterDatabaseTable(ctx context.Context, in *Regi
 	return out, nil
 }
 
+func (c *nexusServiceClient) RegisterQuery(ctx context.Context, in *RegisterQueryRequest, opts ...grpc.CallOption) (*RegisterQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterQueryResponse)
+	err := c.cc.Invoke(ctx, NexusService_RegisterQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nexusServiceClient) StoreValue(ctx context.Context, in *StoreValueRequest, opts ...grpc.CallOption) (*StoreValueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StoreValueResponse)
@@ -118,6 +143,16 @@ func (c *nexusServiceClient) DeletePath(ctx context.Context, in *DeletePathReque
 	return out, nil
 }
 
+func (c *nexusServiceClient) DeclareLineage(ctx context.Context, in *DeclareLineageRequest, opts ...grpc.CallOption) (*DeclareLineageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclareLineageResponse)
+	err := c.cc.Invoke(ctx, NexusService_DeclareLineage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nexusServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &NexusService_ServiceDesc.Streams[0], NexusService_Subscribe_FullMethodName, cOpts...)
@@ -157,6 +192,64 @@ func (c *nexusServiceClient) GetChildren(ctx context.Context, in *GetChildrenReq
 	return out, nil
 }
 
+func (c *nexusServiceClient) ReadDataset(ctx context.Context, in *ReadDatasetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadDatasetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexusService_ServiceDesc.Streams[1], NexusService_ReadDataset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReadDatasetRequest, ReadDatasetResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexusService_ReadDatasetClient = grpc.ServerStreamingClient[ReadDatasetResponse]
+
+func (c *nexusServiceClient) GetPreview(ctx context.Context, in *GetPreviewRequest, opts ...grpc.CallOption) (*GetPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreviewResponse)
+	err := c.cc.Invoke(ctx, NexusService_GetPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusServiceClient) GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*GetLineageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLineageResponse)
+	err := c.cc.Invoke(ctx, NexusService_GetLineage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nexusServiceClient) RelayEventStream(ctx context.Context, in *RelayEventStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RelayMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NexusService_ServiceDesc.Streams[2], NexusService_RelayEventStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RelayEventStreamRequest, RelayMessage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexusService_RelayEventStreamClient = grpc.ServerStreamingClient[RelayMessage]
+
 // NexusServiceServer is the server API for NexusService service.
 // All implementations must embed UnimplementedNexusServiceServer
 // for forward compatibility.
@@ -168,13 +261,22 @@ type NexusServiceServer interface {
 	RegisterFile(context.Context, *RegisterFileRequest) (*RegisterFileResponse, error)
 	RegisterDirectory(context.Context, *RegisterDirectoryRequest) (*RegisterDirectoryResponse, error)
 	RegisterDatabaseTable(context.Context, *RegisterDatabaseTableRequest) (*RegisterDatabaseTableResponse, error)
+	RegisterQuery(context.Context, *RegisterQueryRequest) (*RegisterQueryResponse, error)
 	StoreValue(context.Context, *StoreValueRequest) (*StoreValueResponse, error)
 	DeletePath(context.Context, *DeletePathRequest) (*DeletePathResponse, error)
+	DeclareLineage(context.Context, *DeclareLineageRequest) (*DeclareLineageResponse, error)
 	// Consumer endpoints
 	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Event]) error
 	GetNode(context.Context, *GetPathRequest) (*GetNodeResponse, error)
 	// Add this to the NexusService
 	GetChildren(context.Context, *GetChildrenRequest) (*GetChildrenResponse, error)
+	// Dataset access endpoints
+	ReadDataset(*ReadDatasetRequest, grpc.ServerStreamingServer[ReadDatasetResponse]) error
+	GetPreview(context.Context, *GetPreviewRequest) (*GetPreviewResponse, error)
+	// Lineage endpoints
+	GetLineage(context.Context, *GetLineageRequest) (*GetLineageResponse, error)
+	// Event stream relay endpoints
+	RelayEventStream(*RelayEventStreamRequest, grpc.ServerStreamingServer[RelayMessage]) error
 	mustEmbedUnimplementedNexusServiceServer()
 }
 
@@ -197,12 +299,18 @@ func (UnimplementedNexusServiceServer) RegisterDirectory(context.Context, *Regis
 func (UnimplementedNexusServiceServer) RegisterDatabaseTable(context.Context, *RegisterDatabaseTableRequest) (*RegisterDatabaseTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterDatabaseTable not implemented")
 }
+func (UnimplementedNexusServiceServer) RegisterQuery(context.Context, *RegisterQueryRequest) (*RegisterQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterQuery not implemented")
+}
 func (UnimplementedNexusServiceServer) StoreValue(context.Context, *StoreValueRequest) (*StoreValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreValue not implemented")
 }
 func (UnimplementedNexusServiceServer) DeletePath(context.Context, *DeletePathRequest) (*DeletePathResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePath not implemented")
 }
+func (UnimplementedNexusServiceServer) DeclareLineage(context.Context, *DeclareLineageRequest) (*DeclareLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclareLineage not implemented")
+}
 func (UnimplementedNexusServiceServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[Event]) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -212,6 +320,18 @@ func (UnimplementedNexusServiceServer) GetNode(context.Context, *GetPathRequest)
 func (UnimplementedNexusServiceServer) GetChildren(context.Context, *GetChildrenRequest) (*GetChildrenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChildren not implemented")
 }
+func (UnimplementedNexusServiceServer) ReadDataset(*ReadDatasetRequest, grpc.ServerStreamingServer[ReadDatasetResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReadDataset not implemented")
+}
+func (UnimplementedNexusServiceServer) GetPreview(context.Context, *GetPreviewRequest) (*GetPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreview not implemented")
+}
+func (UnimplementedNexusServiceServer) GetLineage(context.Context, *GetLineageRequest) (*GetLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineage not implemented")
+}
+func (UnimplementedNexusServiceServer) RelayEventStream(*RelayEventStreamRequest, grpc.ServerStreamingServer[RelayMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method RelayEventStream not implemented")
+}
 func (UnimplementedNexusServiceServer) mustEmbedUnimplementedNexusServiceServer() {}
 func (UnimplementedNexusServiceServer) testEmbeddedByValue()                      {}
 
@@ -305,6 +425,24 @@ func _NexusService_RegisterDatabaseTable_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NexusService_RegisterQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusServiceServer).RegisterQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusService_RegisterQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusServiceServer).RegisterQuery(ctx, req.(*RegisterQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NexusService_StoreValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StoreValueRequest)
 	if err := dec(in); err != nil {
@@ -341,6 +479,24 @@ func _NexusService_DeletePath_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NexusService_DeclareLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclareLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusServiceServer).DeclareLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusService_DeclareLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusServiceServer).DeclareLineage(ctx, req.(*DeclareLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NexusService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -388,6 +544,64 @@ func _NexusService_GetChildren_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NexusService_ReadDataset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadDatasetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NexusServiceServer).ReadDataset(m, &grpc.GenericServerStream[ReadDatasetRequest, ReadDatasetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexusService_ReadDatasetServer = grpc.ServerStreamingServer[ReadDatasetResponse]
+
+func _NexusService_GetPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusServiceServer).GetPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusService_GetPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusServiceServer).GetPreview(ctx, req.(*GetPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusService_GetLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NexusServiceServer).GetLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NexusService_GetLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NexusServiceServer).GetLineage(ctx, req.(*GetLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NexusService_RelayEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RelayEventStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NexusServiceServer).RelayEventStream(m, &grpc.GenericServerStream[RelayEventStreamRequest, RelayMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NexusService_RelayEventStreamServer = grpc.ServerStreamingServer[RelayMessage]
+
 // NexusService_ServiceDesc is the grpc.ServiceDesc for NexusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +625,10 @@ var NexusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterDatabaseTable",
 			Handler:    _NexusService_RegisterDatabaseTable_Handler,
 		},
+		{
+			MethodName: "RegisterQuery",
+			Handler:    _NexusService_RegisterQuery_Handler,
+		},
 		{
 			MethodName: "StoreValue",
 			Handler:    _NexusService_StoreValue_Handler,
@@ -419,6 +637,10 @@ var NexusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePath",
 			Handler:    _NexusService_DeletePath_Handler,
 		},
+		{
+			MethodName: "DeclareLineage",
+			Handler:    _NexusService_DeclareLineage_Handler,
+		},
 		{
 			MethodName: "GetNode",
 			Handler:    _NexusService_GetNode_Handler,
@@ -427,6 +649,14 @@ var NexusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChildren",
 			Handler:    _NexusService_GetChildren_Handler,
 		},
+		{
+			MethodName: "GetPreview",
+			Handler:    _NexusService_GetPreview_Handler,
+		},
+		{
+			MethodName: "GetLineage",
+			Handler:    _NexusService_GetLineage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -434,6 +664,16 @@ var NexusService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _NexusService_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ReadDataset",
+			Handler:       _NexusService_ReadDataset_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RelayEventStream",
+			Handler:       _NexusService_RelayEventStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/nexus.proto",
 }
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// Environment variables configuring the datasets the server may open
+const (
+	DataRootsEnv   = "NEXUS_DATA_ROOTS"   // Directories whose files may be read, separated like $PATH
+	DataHostsEnv   = "NEXUS_DATA_HOSTS"   // Comma separated database and event stream hosts, as host or host:port
+	RelayGroupsEnv = "NEXUS_RELAY_GROUPS" // Comma separated consumer groups relay clients may join
+)
+
+// DatasetAccess limits the datasets the server opens on behalf of clients, such as to read or
+// preview them. Anyone can register a dataset and the server opens it with its own rights, so
+// only files under the allowed roots and databases on the allowed hosts are opened. Event streams
+// using the server's SASL credentials or TLS files are only connected to on the allowed hosts, so
+// a registrant can't have them sent to its own brokers. Nothing is allowed by default.
+type DatasetAccess struct {
+	Roots  []string // Directories whose files may be read, with symbolic links resolved
+	Hosts  []string // Database and event stream hosts that may be connected to, on any port or as host:port
+	Groups []string // Consumer groups that relayed event streams may be consumed in
+}
+
+// DatasetAccessFromEnv reads the allowed roots, hosts and relay groups from $NEXUS_DATA_ROOTS,
+// $NEXUS_DATA_HOSTS and $NEXUS_RELAY_GROUPS
+func DatasetAccessFromEnv() *DatasetAccess {
+	log := logger.GetLogger()
+	access := &DatasetAccess{}
+	for _, root := range filepath.SplitList(os.Getenv(DataRootsEnv)) {
+		if root == "" {
+			continue
+		}
+		resolved, err := resolvePath(root)
+		if err != nil {
+			log.Warn("Ignoring dataset root", "root", root, "error", err)
+			continue
+		}
+		access.Roots = append(access.Roots, resolved)
+	}
+	for _, host := range strings.Split(os.Getenv(DataHostsEnv), ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			access.Hosts = append(access.Hosts, strings.ToLower(host))
+		}
+	}
+	for _, group := range strings.Split(os.Getenv(RelayGroupsEnv), ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			access.Groups = append(access.Groups, group)
+		}
+	}
+	log.Info("Dataset access", "roots", access.Roots, "hosts", access.Hosts, "groups", access.Groups)
+	return access
+}
+
+// Check returns an error if a file, directory, database table, event stream or query node value
+// refers to a resource the server may not open. The connection of a query is checked by the caller.
+func (a *DatasetAccess) Check(value interface{}) error {
+	switch v := value.(type) {
+	case *pb.IndividualFile:
+		return a.checkPath(v.FilePath)
+	case *pb.Directory:
+		return a.checkPath(v.DirectoryPath)
+	case *pb.DatabaseTable:
+		if nc.IsFileDatabase(v.DbType) {
+			return a.checkPath(v.DbName)
+		}
+		return a.checkHost(v.Host, strconv.Itoa(int(v.Port)))
+	case *pb.EventStream:
+		if !usesServerSecrets(v) {
+			return nil
+		}
+		for _, server := range nc.StreamServers(v) {
+			if err := a.checkHost(streamHost(server)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// usesServerSecrets reports whether connecting to a stream sends the server's SASL credentials
+// or loads its TLS files
+func usesServerSecrets(es *pb.EventStream) bool {
+	if es.Sasl != nil {
+		return true
+	}
+	return es.Tls != nil && (es.Tls.CaFile != "" || es.Tls.CertFile != "" || es.Tls.KeyFile != "")
+}
+
+// streamHost splits an event stream server, given as host:port or as a URL, into host and port
+func streamHost(server string) (string, string) {
+	if strings.Contains(server, "://") {
+		if u, err := url.Parse(server); err == nil {
+			return u.Hostname(), u.Port()
+		}
+	}
+	if host, port, err := net.SplitHostPort(server); err == nil {
+		return host, port
+	}
+	return server, ""
+}
+
+// checkPath allows paths inside one of the roots, once symbolic links are resolved
+func (a *DatasetAccess) checkPath(path string) error {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return fmt.Errorf("access to %s denied: %v", path, err)
+	}
+	for _, root := range a.Roots {
+		if resolved == root || strings.HasPrefix(resolved, root+string(filepath.Separator)) || root == string(filepath.Separator) {
+			return nil
+		}
+	}
+	return fmt.Errorf("access to %s denied: not under a root in $%s", path, DataRootsEnv)
+}
+
+// checkHost allows hosts listed without a port, or with the given port. Hosts without a port
+// must be listed without one.
+func (a *DatasetAccess) checkHost(host string, port string) error {
+	host = strings.ToLower(host)
+	withPort := host
+	if port != "" {
+		withPort = net.JoinHostPort(host, port)
+	}
+	for _, allowed := range a.Hosts {
+		if allowed == host || allowed == withPort {
+			return nil
+		}
+	}
+	return fmt.Errorf("access to host %s denied: not in $%s", withPort, DataHostsEnv)
+}
+
+// resolvePath returns the absolute path of a file with symbolic links resolved, so links can't
+// lead outside a root
+func resolvePath(path string) (string, error) {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(absolute)
+}
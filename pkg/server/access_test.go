@@ -0,0 +1,111 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+func TestDatasetAccess(t *testing.T) {
+	root, outside := resolved(t, t.TempDir()), resolved(t, t.TempDir())
+	if err := os.WriteFile(filepath.Join(root, "data.csv"), []byte("id\n1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outside, "secret.csv"), []byte("id\n1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// A link inside the root leading out of it
+	if err := os.Symlink(filepath.Join(outside, "secret.csv"), filepath.Join(root, "link.csv")); err != nil {
+		t.Fatal(err)
+	}
+	access := &DatasetAccess{Roots: []string{root}, Hosts: []string{"db.internal", "warehouse:5432"}}
+
+	allowed := []interface{}{
+		nc.CreateIndividualFile(filepath.Join(root, "data.csv")),
+		&pb.Directory{DirectoryPath: root},
+		&pb.DatabaseTable{DbType: "sqlite", DbName: filepath.Join(root, "data.csv")},
+		&pb.DatabaseTable{DbType: "postgres", Host: "DB.internal", Port: 6543},
+		&pb.DatabaseTable{DbType: "mysql", Host: "warehouse", Port: 5432},
+		&pb.StringValue{Value: "values aren't opened"},
+	}
+	for _, value := range allowed {
+		if err := access.Check(value); err != nil {
+			t.Errorf("%v denied: %v", value, err)
+		}
+	}
+
+	denied := []interface{}{
+		nc.CreateIndividualFile(filepath.Join(outside, "secret.csv")),
+		nc.CreateIndividualFile(filepath.Join(root, "link.csv")),
+		nc.CreateIndividualFile(filepath.Join(root, "..", filepath.Base(outside), "secret.csv")),
+		nc.CreateIndividualFile(filepath.Join(root, "missing.csv")),
+		&pb.Directory{DirectoryPath: outside},
+		&pb.DatabaseTable{DbType: "sqlite", DbName: filepath.Join(outside, "secret.csv")},
+		&pb.DatabaseTable{DbType: "postgres", Host: "warehouse", Port: 5433},
+		&pb.DatabaseTable{DbType: "postgres", Host: "db.internal.attacker.example", Port: 5432},
+	}
+	for _, value := range denied {
+		if err := access.Check(value); err == nil {
+			t.Errorf("%v allowed", value)
+		}
+	}
+
+	if err := (&DatasetAccess{}).Check(nc.CreateIndividualFile(filepath.Join(root, "data.csv"))); err == nil {
+		t.Error("file allowed without any root")
+	}
+}
+
+func TestDatasetAccessFromEnv(t *testing.T) {
+	root := resolved(t, t.TempDir())
+	t.Setenv(DataRootsEnv, root+string(os.PathListSeparator)+filepath.Join(root, "missing"))
+	t.Setenv(DataHostsEnv, " DB.internal, warehouse:5432,,")
+	t.Setenv(RelayGroupsEnv, "dashboards")
+
+	access := DatasetAccessFromEnv()
+	// Roots that don't exist are dropped, as they can't be resolved
+	if len(access.Roots) != 1 || access.Roots[0] != root {
+		t.Errorf("roots = %v, want only %s", access.Roots, root)
+	}
+	if len(access.Hosts) != 2 || access.Hosts[0] != "db.internal" || access.Hosts[1] != "warehouse:5432" {
+		t.Errorf("hosts = %v, want db.internal and warehouse:5432", access.Hosts)
+	}
+	if len(access.Groups) != 1 || access.Groups[0] != "dashboards" {
+		t.Errorf("groups = %v, want dashboards", access.Groups)
+	}
+}
+
+// TestStreamAccess checks that streams are only connected to with the server's credentials or
+// TLS files when all their servers are allowed hosts
+func TestStreamAccess(t *testing.T) {
+	access := &DatasetAccess{Hosts: []string{"kafka.internal:9092", "nats.internal"}}
+	sasl := &pb.KafkaSASL{CredentialRef: "env:KAFKA"}
+
+	allowed := []*pb.EventStream{
+		{Brokers: []string{"kafka.internal:9092"}, Sasl: sasl},
+		{Server: "nats://nats.internal:4222", Transport: "nats", Sasl: sasl},
+		{Brokers: []string{"KAFKA.internal:9092"}, Tls: &pb.KafkaTLS{CertFile: "client.pem", KeyFile: "client.key"}},
+		// Without server secrets, any broker can be connected to
+		{Brokers: []string{"attacker.example:9092"}},
+		{Brokers: []string{"attacker.example:9092"}, Tls: &pb.KafkaTLS{ServerName: "kafka"}},
+	}
+	for _, es := range allowed {
+		if err := access.Check(es); err != nil {
+			t.Errorf("stream %v denied: %v", es, err)
+		}
+	}
+
+	denied := []*pb.EventStream{
+		{Brokers: []string{"attacker.example:9092"}, Sasl: sasl},
+		{Brokers: []string{"kafka.internal:9092", "attacker.example:9092"}, Sasl: sasl},
+		{Brokers: []string{"kafka.internal:9093"}, Sasl: sasl},
+		{Server: "tcp://attacker.example:1883", Transport: "mqtt", Tls: &pb.KafkaTLS{CaFile: "ca.pem"}},
+	}
+	for _, es := range denied {
+		if err := access.Check(es); err == nil {
+			t.Errorf("stream %v allowed", es)
+		}
+	}
+}
@@ -8,17 +8,22 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 type TrieNode struct {
 	Children    map[string]*TrieNode
 	IsEndOfPath bool
-	Value       interface{} // Store different types of values
-	ValueType   string      // Type identifier for the value
+	Value       interface{}      // Store different types of values
+	ValueType   string           // Type identifier for the value
+	Health      *pb.HealthStatus // Latest health check of the value's resource, nil if not checked
+	Upstream    []string         // Paths the node is derived from, see DeclareLineage
 }
 
 type Trie struct {
 	Root *TrieNode
+
+	mu sync.RWMutex // Guards the nodes against concurrent requests and background workers
 }
 
 // NewTrie initializes a new Trie, optionally loading from a file
@@ -44,6 +49,32 @@ func NewTrie(filepath ...string) (*Trie, error) {
 // Insert adds a new path to the Trie with an associated value
 func (t *Trie) Insert(path string, value interface{}) {
 	log := logger.GetLogger()
+	valueType := t.insert(path, value)
+	log.Info("Inserted value", "value", value, "type", valueType)
+}
+
+// Update stores a value like Insert without logging it, for values replaced as often as
+// messages arrive. It reports false, storing nothing, if the path already holds a value that
+// replaceable rejects.
+func (t *Trie) Update(path string, value interface{}, replaceable func(current interface{}) bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if node := t.lookup(path); node != nil && node.IsEndOfPath && !replaceable(node.Value) {
+		return false
+	}
+	t.store(path, value)
+	return true
+}
+
+// insert stores a value at a path, creating the nodes leading to it, and returns its type
+func (t *Trie) insert(path string, value interface{}) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.store(path, value)
+}
+
+// store is insert with the lock held by the caller
+func (t *Trie) store(path string, value interface{}) string {
 	node := t.Root
 	segments := splitPath(path) // Customizable segmenter
 	for _, segment := range segments {
@@ -55,14 +86,16 @@ func (t *Trie) Insert(path string, value interface{}) {
 	// TODO: Think about if we want to store data in intermediate nodes and not just at the end of the path
 	node.IsEndOfPath = true
 	node.Value = value // Store the value at the end of the path
+	node.Health = nil  // The new value hasn't been checked yet
 	valueType := reflect.TypeOf(value).Elem().Name()
-	log.Info("Inserted value", "value", value, "type", valueType)
 	node.ValueType = valueType
-
+	return valueType
 }
 
 // Search checks if a path exists in the Trie
 func (t *Trie) Search(path string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	node := t.Root
 	segments := splitPath(path)
 	for _, segment := range segments {
@@ -78,6 +111,8 @@ func (t *Trie) Search(path string) bool {
 func (t *Trie) Traverse() {
 	log := logger.GetLogger()
 	log.Debug("Traversing Trie")
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	t.traverseHelper(t.Root, "")
 }
 
@@ -91,6 +126,23 @@ func (t *Trie) traverseHelper(node *TrieNode, prefix string) {
 	}
 }
 
+// Walk calls fn with the path and value of every node holding a value. The trie is read
+// locked while walking, so fn must not modify it.
+func (t *Trie) Walk(fn func(path string, node *TrieNode)) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	walkHelper(t.Root, "", fn)
+}
+
+func walkHelper(node *TrieNode, prefix string, fn func(path string, node *TrieNode)) {
+	if node.IsEndOfPath {
+		fn(prefix, node)
+	}
+	for segment, child := range node.Children {
+		walkHelper(child, prefix+"/"+segment, fn)
+	}
+}
+
 // splitPath is a helper function to split the path into segments
 func splitPath(path string) []string {
 	// Remove leading and trailing slashes
@@ -101,20 +153,27 @@ func splitPath(path string) []string {
 	return strings.Split(path, "/")
 }
 
+// cleanPath normalizes a path to the form "/a/b" used as a key for nodes
+func cleanPath(path string) string {
+	return "/" + strings.Join(splitPath(path), "/")
+}
+
 // GetType returns the type of value stored at a path
 func (t *Trie) GetType(path string) (string, error) {
 	log := logger.GetLogger()
-	node, err := t.GetNode(path)
+	snapshot, err := t.Snapshot(path)
 	if err != nil {
 		return "", err
 	}
-	log.Debug("Children of root path", "children", t.Root.Children)
-	return node.ValueType, nil
+	log.Debug("Type of path", "path", path, "type", snapshot.ValueType)
+	return snapshot.ValueType, nil
 }
 
 // GetChildren returns a list of child paths for a given path
 func (t *Trie) GetChildren(path string) []*pb.ChildInfo {
 	log := logger.GetLogger()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	node := t.Root
 	if path != "/" {
 		segments := splitPath(path)
@@ -134,16 +193,53 @@ func (t *Trie) GetChildren(path string) []*pb.ChildInfo {
 			Name:        segment,
 			Type:        child.ValueType,
 			NumChildren: int32(len(child.Children)),
+			Health:      nodeHealth(child),
 		})
 	}
 	log.Debug("Children of path", "path", path, "children", children)
 	return children
 }
 
-// GetNode returns the TrieNode at a given path
+// lookup returns the node at a path, or nil if there is none. The caller must hold the lock.
+func (t *Trie) lookup(path string) *TrieNode {
+	node := t.Root
+	for _, segment := range splitPath(path) {
+		child, exists := node.Children[segment]
+		if !exists {
+			return nil
+		}
+		node = child
+	}
+	return node
+}
+
+// NodeSnapshot is what a node holds, read together under the trie's lock so that it stays
+// consistent while the node is updated
+type NodeSnapshot struct {
+	Value     interface{}
+	ValueType string
+	Health    *pb.HealthStatus // See nodeHealth
+}
+
+// Snapshot returns what the node at a path holds. Use it rather than reading the fields of a
+// node returned by GetNode, which may be updated as soon as the lock is released.
+func (t *Trie) Snapshot(path string) (*NodeSnapshot, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	node := t.lookup(path)
+	if node == nil {
+		return nil, fmt.Errorf("path not found: %s", path)
+	}
+	return &NodeSnapshot{Value: node.Value, ValueType: node.ValueType, Health: nodeHealth(node)}, nil
+}
+
+// GetNode returns the TrieNode at a given path. Its fields may only be read with the trie's
+// lock held, see Snapshot.
 func (t *Trie) GetNode(path string) (*TrieNode, error) {
 	log := logger.GetLogger()
 	log.Debug("Getting node", "path", path)
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	node := t.Root
 	if path != "/" {
 		segments := splitPath(path)
@@ -160,10 +256,52 @@ func (t *Trie) GetNode(path string) (*TrieNode, error) {
 	return node, nil
 }
 
+// SetHealth records the result of a health check of a node's value. Results for a value that
+// has since been replaced are dropped.
+func (t *Trie) SetHealth(node *TrieNode, value interface{}, health *pb.HealthStatus) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if node.Value == value {
+		node.Health = health
+	}
+}
+
+// ValueAt returns the value held at a path, or nil if there is none
+func (t *Trie) ValueAt(path string) interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if node := t.lookup(path); node != nil && node.IsEndOfPath {
+		return node.Value
+	}
+	return nil
+}
+
+// NodeValue returns the value held by a node
+func (t *Trie) NodeValue(node *TrieNode) interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return node.Value
+}
+
+// ReplaceValue swaps the value of a node for an updated copy of the same type, keeping its
+// health. It reports false, leaving the node unchanged, if the value was replaced since it
+// was read.
+func (t *Trie) ReplaceValue(node *TrieNode, old interface{}, value interface{}) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if node.Value != old {
+		return false
+	}
+	node.Value = value
+	return true
+}
+
 // Delete deletes a path from the Trie
 func (t *Trie) Delete(path string) (bool, error) {
 	log := logger.GetLogger()
 	log.Debug("Deleting path", "path", path)
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	node := t.Root
 	segments := splitPath(path)
 
@@ -196,6 +334,8 @@ func (t *Trie) Delete(path string) (bool, error) {
 // SaveToDisk saves the Trie to a file
 func (t *Trie) SaveToDisk(filename string) error {
 	log := logger.GetLogger()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	file, err := os.Create(filename)
 	if err != nil {
 		log.Error("Error encoding Trie", "error", err)
@@ -260,6 +400,9 @@ func loadNodeValues(node *TrieNode) {
 		case "DatabaseTable":
 			log.Debug("Found database table")
 			node.Value = parseDatabaseTable(node.Value.(map[string]interface{}))
+		case "Query":
+			log.Debug("Found query")
+			node.Value = parseQuery(node.Value)
 		case "StringValue":
 			log.Debug("Found string value")
 			// Extract the actual string value from the representation
@@ -290,6 +433,13 @@ func loadNodeValues(node *TrieNode) {
 				log.Debug("Successfully found float value as map")
 				node.Value = &pb.FloatValue{Value: float32(valueMap["value"].(float64))}
 			}
+		case "BoolValue":
+			log.Debug("Found bool value")
+			if valueMap, ok := node.Value.(map[string]interface{}); ok {
+				// False is omitted when saved
+				value, _ := valueMap["value"].(bool)
+				node.Value = &pb.BoolValue{Value: value}
+			}
 		default:
 			log.Error("Unknown type: %s", node.ValueType)
 			node.Value = nil // Handle unknown types
@@ -302,60 +452,72 @@ func loadNodeValues(node *TrieNode) {
 	}
 }
 
+// decodeNodeValue converts a value decoded from disk as a generic map into the given message
+// by round-tripping it through JSON, so every field of the message is restored
+func decodeNodeValue(nodeValue interface{}, message interface{}) bool {
+	log := logger.GetLogger()
+	valueMap, ok := nodeValue.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	data, err := json.Marshal(valueMap)
+	if err != nil {
+		log.Error("Failed to encode node value", "error", err)
+		return false
+	}
+	if err := json.Unmarshal(data, message); err != nil {
+		log.Error("Failed to decode node value", "error", err)
+		return false
+	}
+	return true
+}
+
 func parseIndividualFile(nodeValue interface{}) *pb.IndividualFile {
 	log := logger.GetLogger()
-	if valueMap, ok := nodeValue.(map[string]interface{}); ok {
+	file := &pb.IndividualFile{}
+	if decodeNodeValue(nodeValue, file) {
 		log.Debug("Successfully found individual file as map")
-		var columnNames []string
-		if cols, exists := valueMap["column_names"]; exists {
-			colStr := cols.(string)
-			columnNames = strings.Split(colStr, " ")
-		}
-		return &pb.IndividualFile{
-			FilePath:    valueMap["file_path"].(string),
-			FileType:    valueMap["file_type"].(string),
-			ColumnNames: columnNames,
-		}
+		return file
 	}
 	return nil
 }
 
 func parseDirectory(nodeValue interface{}) *pb.Directory {
 	log := logger.GetLogger()
-	if valueMap, ok := nodeValue.(map[string]interface{}); ok {
+	directory := &pb.Directory{}
+	if decodeNodeValue(nodeValue, directory) {
 		log.Debug("Successfully found directory as map")
-		return &pb.Directory{
-			FileType:      valueMap["file_type"].(string),
-			DirectoryPath: valueMap["directory_path"].(string),
-			FileCount:     int32(valueMap["file_count"].(float64)),
-		}
+		return directory
 	}
 	return nil
 }
 
 func parseDatabaseTable(nodeValue interface{}) *pb.DatabaseTable {
 	log := logger.GetLogger()
-	if valueMap, ok := nodeValue.(map[string]interface{}); ok {
+	table := &pb.DatabaseTable{}
+	if decodeNodeValue(nodeValue, table) {
 		log.Debug("Successfully found database table as map")
-		return &pb.DatabaseTable{
-			DbType:    valueMap["db_type"].(string),
-			Host:      valueMap["host"].(string),
-			Port:      int32(valueMap["port"].(float64)),
-			DbName:    valueMap["db_name"].(string),
-			TableName: valueMap["table_name"].(string),
-		}
+		return table
+	}
+	return nil
+}
+
+func parseQuery(nodeValue interface{}) *pb.Query {
+	log := logger.GetLogger()
+	query := &pb.Query{}
+	if decodeNodeValue(nodeValue, query) {
+		log.Debug("Successfully found query as map")
+		return query
 	}
 	return nil
 }
 
 func parseEventStream(nodeValue interface{}) *pb.EventStream {
 	log := logger.GetLogger()
-	if valueMap, ok := nodeValue.(map[string]interface{}); ok {
+	eventStream := &pb.EventStream{}
+	if decodeNodeValue(nodeValue, eventStream) {
 		log.Debug("Successfully found event stream as map")
-		return &pb.EventStream{
-			Server: valueMap["server"].(string),
-			Topic:  valueMap["topic"].(string),
-		}
+		return eventStream
 	}
 	return nil
 }
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"nexus/pkg/logger"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// ReadDataset implements the dataset access endpoint. The server opens the registered
+// file, directory or database table itself and streams the rows back in batches.
+func (s *NexusServer) ReadDataset(req *pb.ReadDatasetRequest, stream pb.NexusService_ReadDatasetServer) error {
+	log := logger.GetLogger()
+	log.Info("Received request to read dataset", "path", req.Path)
+
+	// Bring the fingerprint of files and directories up to date, so unchanged ones can be skipped.
+	// Nothing is read before access is checked, not even to fingerprint it.
+	var fingerprint *pb.Fingerprint
+	if node, err := s.Index.GetNode(req.Path); err == nil {
+		if err := s.Access.Check(s.Index.NodeValue(node)); err != nil {
+			log.Warn("Dataset access denied", "path", req.Path, "error", err)
+			return stream.Send(&pb.ReadDatasetResponse{Error: err.Error()})
+		}
+		fingerprint, err = refreshFingerprint(s.Index, s.Events, s.Access, req.Path, node)
+		if err != nil {
+			log.Error("Failed to refresh fingerprint", "path", req.Path, "error", err)
+		}
+	}
+	if req.IfChangedFrom != "" && fingerprint != nil && fingerprint.Hash == req.IfChangedFrom {
+		log.Debug("Dataset not modified", "path", req.Path)
+		return stream.Send(&pb.ReadDatasetResponse{Fingerprint: fingerprint, NotModified: true})
+	}
+
+	cursor, err := s.openDataset(req)
+	if err != nil {
+		log.Error("Failed to open dataset", "path", req.Path, "error", err)
+		return stream.Send(&pb.ReadDatasetResponse{Error: err.Error()})
+	}
+	defer cursor.Close()
+
+	res := &pb.ReadDatasetResponse{Columns: nc.ColumnsToProto(cursor.Columns()), Fingerprint: fingerprint}
+	for {
+		batch, err := cursor.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Error("Failed to read dataset", "path", req.Path, "error", err)
+			res.Error = err.Error()
+			return stream.Send(res)
+		}
+
+		for _, row := range batch {
+			res.Rows = append(res.Rows, nc.RowToProto(row))
+		}
+		if err := stream.Send(res); err != nil {
+			log.Error("Failed to send dataset rows", "path", req.Path, "error", err)
+			return err
+		}
+		res = &pb.ReadDatasetResponse{}
+	}
+
+	// Always send the columns, even for an empty result
+	if res.Columns != nil {
+		return stream.Send(res)
+	}
+	return nil
+}
+
+// queryConnection returns the registered database table whose connection a query runs on
+func queryConnection(index *Trie, query *pb.Query) (*pb.DatabaseTable, error) {
+	node, err := index.Snapshot(query.ConnectionPath)
+	if err != nil {
+		return nil, err
+	}
+	connection, ok := node.Value.(*pb.DatabaseTable)
+	if !ok {
+		return nil, fmt.Errorf("no database table found at connection path: %s", query.ConnectionPath)
+	}
+	return connection, nil
+}
+
+// openDataset opens a cursor over the dataset registered at the requested path
+func (s *NexusServer) openDataset(req *pb.ReadDatasetRequest) (*nc.Cursor, error) {
+	node, err := s.Index.Snapshot(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	options, dirOptions := nc.ReadOptionsFromProto(req)
+	cursor, err := openDatasetValue(s.Index, s.Access, node.Value, options, dirOptions)
+	if err == errNotDataset {
+		return nil, fmt.Errorf("no dataset found at path: %s", req.Path)
+	}
+	return cursor, err
+}
+
+// errNotDataset is returned when opening a node value that isn't a dataset
+var errNotDataset = errors.New("not a dataset")
+
+// openDatasetValue opens a cursor over a file, directory, database table or query node value,
+// if access allows the server to open it
+func openDatasetValue(index *Trie, access *DatasetAccess, value interface{}, options nc.ReadOptions, dirOptions nc.DirectoryReadOptions) (*nc.Cursor, error) {
+	if err := access.Check(value); err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case *pb.IndividualFile:
+		return nc.OpenIndividualFile(v, options)
+	case *pb.Directory:
+		return nc.OpenDirectory(v, dirOptions, options)
+	case *pb.DatabaseTable:
+		return nc.OpenDatabaseTable(v, options)
+	case *pb.Query:
+		connection, err := queryConnection(index, v)
+		if err != nil {
+			return nil, err
+		}
+		if err := access.Check(connection); err != nil {
+			return nil, err
+		}
+		return nc.OpenQuery(v, connection, options)
+	default:
+		return nil, errNotDataset
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	nc "nexus/pkg/client"
+)
+
+// TestReadDataset reads a file through the server, which applies the read options and streams
+// the rows in batches
+func TestReadDataset(t *testing.T) {
+	dir := resolved(t, t.TempDir())
+	filePath := filepath.Join(dir, "people.csv")
+	content := "id,name,age\n1,Ada,36\n2,Grace,45\n3,Alan,41\n4,Edsger,72\n"
+	if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, client := newTestServer(t, dir)
+	if err := client.PublishIndividualFile("/people", nc.CreateIndividualFile(filePath)); err != nil {
+		t.Fatal(err)
+	}
+
+	options := nc.ReadOptions{
+		Columns:   []string{"name", "age"},
+		Filters:   []nc.Filter{{Column: "age", Op: ">", Value: "40"}},
+		Offset:    1,
+		BatchSize: 1,
+	}
+	cursor, err := client.ReadDataset("/people", options, nc.DirectoryReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := cursor.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(table.ColumnNames(), []string{"name", "age"}) || !reflect.DeepEqual(table.ColumnTypes(), []string{nc.TypeString, nc.TypeInt}) {
+		t.Errorf("columns = %v of types %v", table.ColumnNames(), table.ColumnTypes())
+	}
+	want := [][]interface{}{{"Alan", int64(41)}, {"Edsger", int64(72)}}
+	if !reflect.DeepEqual(table.Rows, want) {
+		t.Errorf("rows = %v, want %v", table.Rows, want)
+	}
+
+	if _, err := client.ReadDataset("/missing", nc.ReadOptions{}, nc.DirectoryReadOptions{}); err == nil {
+		t.Error("read a path that isn't registered")
+	}
+	if _, err := client.ReadDataset("/people", nc.ReadOptions{Columns: []string{"email"}}, nc.DirectoryReadOptions{}); err == nil {
+		t.Error("read a column the file doesn't have")
+	}
+}
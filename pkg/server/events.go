@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"nexus/pkg/logger"
+	"strings"
+	"sync"
+	"time"
+
+	pb "nexus/pkg/proto"
+)
+
+// subscriberBuffer is the number of events buffered per subscriber before events are dropped
+const subscriberBuffer = 64
+
+// EventHub fans events about nodes out to the subscribers of their paths
+type EventHub struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	path      string
+	recursive bool
+	events    chan *pb.Event
+}
+
+// NewEventHub creates an event hub without subscribers
+func NewEventHub() *EventHub {
+	return &EventHub{subscribers: map[*subscriber]struct{}{}}
+}
+
+// Subscribe returns a channel receiving the events of a path, and of the paths below it if
+// recursive, along with a function ending the subscription
+func (h *EventHub) Subscribe(path string, recursive bool) (<-chan *pb.Event, func()) {
+	sub := &subscriber{path: cleanPath(path), recursive: recursive, events: make(chan *pb.Event, subscriberBuffer)}
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	return sub.events, func() {
+		h.mu.Lock()
+		delete(h.subscribers, sub)
+		h.mu.Unlock()
+	}
+}
+
+// Publish sends an event to the subscribers of its path. Subscribers too slow to keep up miss
+// the event rather than holding up the publisher.
+func (h *EventHub) Publish(event *pb.Event) {
+	log := logger.GetLogger()
+	event.Path = cleanPath(event.Path)
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixMilli()
+	}
+	log.Debug("Publishing event", "path", event.Path, "type", event.Type)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers {
+		if !sub.matches(event.Path) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Warn("Dropped event for slow subscriber", "path", event.Path, "subscription", sub.path)
+		}
+	}
+}
+
+func (s *subscriber) matches(path string) bool {
+	if path == s.path {
+		return true
+	}
+	return s.recursive && (s.path == "/" || strings.HasPrefix(path, s.path+"/"))
+}
+
+// Subscribe implements the consumer endpoint streaming the events of a path until the client
+// disconnects
+func (s *NexusServer) Subscribe(req *pb.SubscribeRequest, stream pb.NexusService_SubscribeServer) error {
+	log := logger.GetLogger()
+	log.Info("Received subscription request", "path", req.Path, "recursive", req.Recursive)
+
+	events, unsubscribe := s.Events.Subscribe(req.Path, req.Recursive)
+	defer unsubscribe()
+	return forwardEvents(stream.Context(), events, stream.Send)
+}
+
+// forwardEvents sends events until ctx is done or sending fails
+func forwardEvents(ctx context.Context, events <-chan *pb.Event, send func(*pb.Event) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// TestExport exports a filtered file dataset to a file of another format and registers the
+// export downstream of it
+func TestExport(t *testing.T) {
+	dir := resolved(t, t.TempDir())
+	source := filepath.Join(dir, "orders.csv")
+	if err := os.WriteFile(source, []byte("id,total\n1,5\n2,20\n3,30\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, client := newTestServer(t, dir)
+	if err := client.PublishIndividualFile("/orders", nc.CreateIndividualFile(source)); err != nil {
+		t.Fatal(err)
+	}
+
+	target := filepath.Join(t.TempDir(), "large.json")
+	options := nc.ExportOptions{
+		Read:       nc.ReadOptions{Filters: []nc.Filter{{Column: "total", Op: ">", Value: int64(10)}}},
+		RegisterAs: "/orders/large",
+	}
+	count, err := client.Export("/orders", target, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("exported %d rows, want 2", count)
+	}
+
+	value, _, err := client.GetFull("/orders/large")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, ok := value.(*pb.IndividualFile)
+	if !ok || file.FilePath != target || file.FileType != ".json" {
+		t.Fatalf("export registered as %v", value)
+	}
+	dependents, err := client.GetDependents("/orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(dependents, "/orders/large") {
+		t.Errorf("dependents of the source are %v, want the export", dependents)
+	}
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// refreshFingerprint recomputes the fingerprint of the file or directory held by a node and
+// stores it on a copy of the node's value, publishing a changed event if the contents differ
+// from the previous fingerprint. It returns the current fingerprint, or nil for other values.
+// Files the server may not open aren't read, as their fingerprint would reveal whether a guess
+// of their contents is right.
+func refreshFingerprint(index *Trie, events *EventHub, access *DatasetAccess, path string, node *TrieNode) (*pb.Fingerprint, error) {
+	value := index.NodeValue(node)
+	if err := access.Check(value); err != nil {
+		return nil, err
+	}
+	fingerprint, err := nc.FingerprintValue(value)
+	if err != nil || fingerprint == nil {
+		return nil, err
+	}
+
+	var previous *pb.Fingerprint
+	updated := proto.Clone(value.(proto.Message))
+	switch v := updated.(type) {
+	case *pb.IndividualFile:
+		previous, v.Fingerprint = v.Fingerprint, fingerprint
+	case *pb.Directory:
+		previous, v.Fingerprint = v.Fingerprint, fingerprint
+	}
+	if proto.Equal(previous, fingerprint) {
+		return fingerprint, nil
+	}
+
+	// Only the refresh that replaces the value reports the change
+	if index.ReplaceValue(node, value, updated) && previous != nil && previous.Hash != fingerprint.Hash {
+		events.Publish(&pb.Event{Path: path, Type: nc.EventChanged, Fingerprint: fingerprint})
+	}
+	return fingerprint, nil
+}
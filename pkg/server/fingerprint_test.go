@@ -0,0 +1,87 @@
+package server
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+func TestReadDatasetIfChanged(t *testing.T) {
+	dir := resolved(t, t.TempDir())
+	filePath := filepath.Join(dir, "data.csv")
+	if err := os.WriteFile(filePath, []byte("id\n1\n2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, client := newTestServer(t, dir)
+	if err := client.PublishIndividualFile("/data", nc.CreateIndividualFile(filePath)); err != nil {
+		t.Fatal(err)
+	}
+
+	cursor, fingerprint, err := client.ReadDatasetIfChanged("/data", "", nc.ReadOptions{}, nc.DirectoryReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := cursor.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Rows) != 2 || fingerprint == nil || fingerprint.Hash == "" {
+		t.Fatalf("read %d rows with fingerprint %v, want 2 rows and a hash", len(table.Rows), fingerprint)
+	}
+
+	_, same, err := client.ReadDatasetIfChanged("/data", fingerprint.Hash, nc.ReadOptions{}, nc.DirectoryReadOptions{})
+	if !errors.Is(err, nc.ErrNotModified) || same.GetHash() != fingerprint.Hash {
+		t.Fatalf("unchanged read returned %v with hash %q", err, same.GetHash())
+	}
+
+	if err := os.WriteFile(filePath, []byte("id\n1\n2\n3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cursor, changed, err := client.ReadDatasetIfChanged("/data", fingerprint.Hash, nc.ReadOptions{}, nc.DirectoryReadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor.Close()
+	if changed.GetHash() == fingerprint.Hash {
+		t.Error("changed file kept its fingerprint")
+	}
+}
+
+// TestReadDatasetDeniedFingerprint checks that a file outside the allowed roots isn't
+// fingerprinted, so its hash can't be used to confirm a guess of its contents
+func TestReadDatasetDeniedFingerprint(t *testing.T) {
+	allowed, denied := resolved(t, t.TempDir()), resolved(t, t.TempDir())
+	filePath := filepath.Join(denied, "secret.csv")
+	if err := os.WriteFile(filePath, []byte("token\nabc\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	guess, err := nc.FingerprintFile(filePath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server, client := newTestServer(t, allowed)
+	if err := client.PublishIndividualFile("/secret", nc.CreateIndividualFile(filePath)); err != nil {
+		t.Fatal(err)
+	}
+
+	_, fingerprint, err := client.ReadDatasetIfChanged("/secret", guess.Hash, nc.ReadOptions{}, nc.DirectoryReadOptions{})
+	if err == nil || errors.Is(err, nc.ErrNotModified) {
+		t.Fatalf("read outside the roots returned %v, want an access error", err)
+	}
+	if fingerprint != nil {
+		t.Errorf("denied read returned fingerprint %v", fingerprint)
+	}
+
+	node, err := server.Index.Snapshot("/secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Value.(*pb.IndividualFile).Fingerprint != nil {
+		t.Error("denied file was fingerprinted in the index")
+	}
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"nexus/pkg/logger"
+	"sync"
+	"time"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+const (
+	DefaultHealthCheckInterval = time.Minute
+	DefaultHealthCheckTimeout  = 10 * time.Second
+	healthCheckParallelism     = 4
+)
+
+// HealthChecker periodically probes the resources behind registered files, directories,
+// database tables, queries and event streams, recording the results on their nodes. The
+// fingerprints of files and directories are refreshed along the way, publishing changed
+// events for those whose contents changed. Resources the server may not open are reported
+// unchecked without being touched.
+type HealthChecker struct {
+	Interval time.Duration // Time between rounds of checks
+	Timeout  time.Duration // Maximum duration of a single check
+
+	index  *Trie
+	events *EventHub
+	access *DatasetAccess
+	wake   chan struct{}
+}
+
+// NewHealthChecker creates a health checker for the resources registered in an index, probing
+// only those access allows the server to open
+func NewHealthChecker(index *Trie, events *EventHub, access *DatasetAccess) *HealthChecker {
+	return &HealthChecker{
+		Interval: DefaultHealthCheckInterval,
+		Timeout:  DefaultHealthCheckTimeout,
+		index:    index,
+		events:   events,
+		access:   access,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Start checks every registered resource in the background, once per Interval and whenever
+// Notify is called
+func (h *HealthChecker) Start() {
+	log := logger.GetLogger()
+	log.Info("Starting health checker", "interval", h.Interval, "timeout", h.Timeout)
+	go func() {
+		for {
+			h.checkAll()
+			select {
+			case <-time.After(h.Interval):
+			case <-h.wake:
+			}
+		}
+	}()
+}
+
+// Notify asks for a round of checks as soon as possible, such as after a new registration,
+// so new resources don't stay unchecked for a whole interval
+func (h *HealthChecker) Notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// checkAll checks every registered resource, a few at a time
+func (h *HealthChecker) checkAll() {
+	log := logger.GetLogger()
+
+	type resource struct {
+		path  string
+		node  *TrieNode
+		value interface{}
+	}
+	var resources []resource
+	h.index.Walk(func(path string, node *TrieNode) {
+		if hasResource(node.Value) {
+			resources = append(resources, resource{path, node, node.Value})
+		}
+	})
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, healthCheckParallelism)
+	for _, r := range resources {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(r resource) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			health := h.check(r.value)
+			if health.Status != nc.HealthHealthy && health.Status != nc.HealthUnchecked {
+				log.Warn("Resource is not healthy", "path", r.path, "status", health.Status, "error", health.LastError)
+			}
+			h.index.SetHealth(r.node, r.value, health)
+
+			if health.Status != nc.HealthUnhealthy && health.Status != nc.HealthUnchecked {
+				if _, err := refreshFingerprint(h.index, h.events, h.access, r.path, r.node); err != nil {
+					log.Error("Failed to refresh fingerprint", "path", r.path, "error", err)
+				}
+			}
+		}(r)
+	}
+	wg.Wait()
+	log.Debug("Checked resource health", "resources", len(resources))
+}
+
+// check probes the resource behind a node value. Queries are as healthy as their connection.
+func (h *HealthChecker) check(value interface{}) *pb.HealthStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), h.Timeout)
+	defer cancel()
+
+	if query, ok := value.(*pb.Query); ok {
+		connection, err := queryConnection(h.index, query)
+		if err != nil {
+			return &pb.HealthStatus{Status: nc.HealthUnhealthy, LastChecked: time.Now().Unix(), LastError: err.Error()}
+		}
+		value = connection
+	}
+	if err := h.access.Check(value); err != nil {
+		return &pb.HealthStatus{Status: nc.HealthUnchecked, LastChecked: time.Now().Unix(), LastError: err.Error()}
+	}
+	return nc.CheckHealth(ctx, value)
+}
+
+// hasResource reports whether a node value refers to an external resource that is health checked
+func hasResource(value interface{}) bool {
+	switch value.(type) {
+	case *pb.IndividualFile, *pb.Directory, *pb.DatabaseTable, *pb.Query, *pb.EventStream:
+		return true
+	}
+	return false
+}
+
+// nodeHealth returns the health reported for a node, unknown for resources not checked yet and
+// nil for nodes without a resource. The caller must hold the trie's lock.
+func nodeHealth(node *TrieNode) *pb.HealthStatus {
+	if node.Health == nil && hasResource(node.Value) {
+		return &pb.HealthStatus{Status: nc.HealthUnknown}
+	}
+	return node.Health
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// TestHealthCheckerAccess checks that resources outside the allowed roots are reported
+// unchecked without being fingerprinted, while allowed ones are probed and fingerprinted
+func TestHealthCheckerAccess(t *testing.T) {
+	allowed, denied := t.TempDir(), t.TempDir()
+	for _, dir := range []string{allowed, denied} {
+		if err := os.WriteFile(filepath.Join(dir, "data.csv"), []byte("id\n1\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	access := &DatasetAccess{Roots: []string{resolved(t, allowed)}}
+
+	index, err := NewTrie()
+	if err != nil {
+		t.Fatal(err)
+	}
+	index.Insert("/allowed", nc.CreateIndividualFile(filepath.Join(allowed, "data.csv")))
+	index.Insert("/denied", nc.CreateIndividualFile(filepath.Join(denied, "data.csv")))
+	index.Insert("/table", &pb.DatabaseTable{DbType: "postgres", Host: "db.internal", Port: 5432, TableName: "t"})
+	index.Insert("/stream", &pb.EventStream{Brokers: []string{"broker.example:9092"}, Topic: "t", Sasl: &pb.KafkaSASL{CredentialRef: "env:KAFKA"}})
+
+	checker := NewHealthChecker(index, NewEventHub(), access)
+	checker.checkAll()
+
+	node, err := index.Snapshot("/allowed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Health.Status != nc.HealthHealthy {
+		t.Errorf("allowed file is %s: %s", node.Health.Status, node.Health.LastError)
+	}
+	if node.Value.(*pb.IndividualFile).Fingerprint == nil {
+		t.Error("allowed file wasn't fingerprinted")
+	}
+
+	for _, path := range []string{"/denied", "/table", "/stream"} {
+		node, err := index.Snapshot(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node.Health.Status != nc.HealthUnchecked || node.Health.LastError == "" {
+			t.Errorf("%s health = %v, want unchecked with the reason", path, node.Health)
+		}
+		if file, ok := node.Value.(*pb.IndividualFile); ok && file.Fingerprint != nil {
+			t.Errorf("%s was fingerprinted outside the allowed roots", path)
+		}
+	}
+}
+
+// resolved returns a directory with symbolic links resolved, as the roots of DatasetAccess are
+func resolved(t *testing.T, dir string) string {
+	t.Helper()
+	path, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"nexus/pkg/logger"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// lastValueRetry is how long a last value cache waits before consuming its stream again after
+// failing to subscribe or the stream ending
+const lastValueRetry = 5 * time.Second
+
+// LastValueBridge keeps the latest message of event streams registered with a LastValueCache
+// in a value node, consuming the streams on the server. Clients read the cached value with
+// GetNode or GetValue, and subscribers of the node get a registered event on every update.
+type LastValueBridge struct {
+	index  *Trie
+	events *EventHub
+	access *DatasetAccess
+
+	mu      sync.Mutex
+	bridges map[string]*lastValueCache // Running caches, by stream path
+}
+
+// lastValueCache is the consumer keeping the last value of one event stream
+type lastValueCache struct {
+	es     *pb.EventStream
+	cancel context.CancelFunc
+	done   chan struct{} // Closed once the consumer has stopped
+}
+
+// NewLastValueBridge creates a bridge for the event streams registered in an index, which
+// caches nothing until started. Streams the server may not connect to aren't cached.
+func NewLastValueBridge(index *Trie, events *EventHub, access *DatasetAccess) *LastValueBridge {
+	return &LastValueBridge{index: index, events: events, access: access, bridges: map[string]*lastValueCache{}}
+}
+
+// LastValuePath returns the path of the value node caching the latest message of the event
+// stream registered at a path
+func LastValuePath(path string, es *pb.EventStream) string {
+	if cachePath := es.GetLastValue().GetPath(); cachePath != "" {
+		return cleanPath(cachePath)
+	}
+	return cleanPath(path) + "/latest"
+}
+
+// validateLastValue checks the last value cache of an event stream about to be registered at a
+// path. The server must be allowed to connect to the stream, its cache path may only hold a
+// value, which the cache replaces, and may not be the cache path of another stream.
+func validateLastValue(index *Trie, access *DatasetAccess, path string, es *pb.EventStream) error {
+	if es.LastValue == nil {
+		return nil
+	}
+	if err := access.Check(es); err != nil {
+		return fmt.Errorf("the last value can't be cached: %v", err)
+	}
+	path = cleanPath(path)
+	cachePath := LastValuePath(path, es)
+	if cachePath == path {
+		return fmt.Errorf("the last value cache can't replace its own event stream")
+	}
+	if snapshot, err := index.Snapshot(cachePath); err == nil && snapshot.Value != nil && !isCacheValue(snapshot.Value) {
+		return fmt.Errorf("the last value cache can't replace the %s at %s", snapshot.ValueType, cachePath)
+	}
+	var other string
+	index.Walk(func(streamPath string, node *TrieNode) {
+		if registered, ok := node.Value.(*pb.EventStream); ok && registered.LastValue != nil && streamPath != path && LastValuePath(streamPath, registered) == cachePath {
+			other = streamPath
+		}
+	})
+	if other != "" {
+		return fmt.Errorf("%s is already the last value cache of the event stream at %s", cachePath, other)
+	}
+	if _, err := nc.NewDecoder(es); err != nil {
+		return fmt.Errorf("can't decode messages for the last value cache: %v", err)
+	}
+	return nil
+}
+
+// Start caches the last values of the event streams already in the index, such as those
+// loaded from disk
+func (b *LastValueBridge) Start() {
+	log := logger.GetLogger()
+	streams := map[string]*pb.EventStream{}
+	b.index.Walk(func(path string, node *TrieNode) {
+		if es, ok := node.Value.(*pb.EventStream); ok && es.LastValue != nil {
+			streams[path] = es
+		}
+	})
+	log.Info("Starting last value caches", "streams", len(streams))
+	for path, es := range streams {
+		b.Update(path, es)
+	}
+}
+
+// Update starts, restarts or stops the last value cache of the event stream registered at a
+// path, following its current settings
+func (b *LastValueBridge) Update(path string, es *pb.EventStream) {
+	path = cleanPath(path)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if running := b.bridges[path]; running != nil {
+		select {
+		case <-running.done: // Stopped by itself, as the stream was replaced
+		default:
+			if proto.Equal(running.es, es) {
+				return
+			}
+		}
+		running.stop()
+		delete(b.bridges, path)
+	}
+	if es.GetLastValue() == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cache := &lastValueCache{es: es, cancel: cancel, done: make(chan struct{})}
+	b.bridges[path] = cache
+	go b.run(ctx, path, cache)
+}
+
+// Stop stops the last value caches of the event streams at or below a path, such as before
+// deleting it
+func (b *LastValueBridge) Stop(path string) {
+	path = cleanPath(path)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for streamPath, cache := range b.bridges {
+		if path == "/" || streamPath == path || strings.HasPrefix(streamPath, path+"/") {
+			cache.stop()
+			delete(b.bridges, streamPath)
+		}
+	}
+}
+
+// stop cancels the consumer and waits for it, so it can't write the cache afterwards
+func (c *lastValueCache) stop() {
+	c.cancel()
+	<-c.done
+}
+
+// run consumes the stream of a cache until it is stopped or the stream is replaced,
+// subscribing again whenever the stream fails
+func (b *LastValueBridge) run(ctx context.Context, path string, cache *lastValueCache) {
+	log := logger.GetLogger()
+	defer close(cache.done)
+
+	es := cache.es
+	cachePath := LastValuePath(path, es)
+	// Streams loaded from disk weren't checked when registered, or access changed since
+	if err := b.access.Check(es); err != nil {
+		log.Error("Not caching last value of event stream", "path", path, "error", err)
+		return
+	}
+	decoder, err := nc.NewDecoder(es)
+	if err != nil {
+		log.Error("Can't decode messages for the last value cache", "path", path, "error", err)
+		return
+	}
+
+	log.Info("Caching last value of event stream", "path", path, "cache", cachePath, "field", es.LastValue.Field)
+	var latest time.Time
+	for {
+		err := b.follow(ctx, path, cachePath, es, decoder, &latest)
+		if ctx.Err() != nil || !b.current(path, es) {
+			log.Info("Stopped caching last value of event stream", "path", path)
+			return
+		}
+		log.Warn("Last value cache stopped consuming, retrying", "path", path, "error", err, "retry", lastValueRetry)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(lastValueRetry):
+		}
+	}
+}
+
+// follow consumes a stream from its last message, storing the cached field of every message
+// newer than the last one stored, until the stream ends or the consumer is stopped. Messages
+// without the field or that can't be decoded are skipped.
+func (b *LastValueBridge) follow(ctx context.Context, path string, cachePath string, es *pb.EventStream, decoder nc.Decoder, latest *time.Time) error {
+	log := logger.GetLogger()
+	options := nc.StreamOptions{
+		LastN:      1,
+		Standalone: true,
+		OnError: func(err error) {
+			log.Warn("Error consuming event stream for the last value cache", "path", path, "error", err)
+		},
+	}
+	subscription, err := nc.SubscribeEventStream(ctx, es, options)
+	if err != nil && ctx.Err() == nil {
+		// Transports that only deliver new messages can't start at the last one
+		options.LastN = 0
+		subscription, err = nc.SubscribeEventStream(ctx, es, options)
+	}
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+
+	field := es.LastValue.Field
+	blocked := false
+	for message := range subscription.Messages() {
+		record, err := nc.DecodeMessage(decoder, message)
+		if err != nil {
+			log.Warn("Skipping message the last value cache can't decode", "path", path, "offset", message.Offset, "error", err)
+			continue
+		}
+		value, ok := record.Field(field)
+		if !ok {
+			log.Debug("Skipping message without the cached field", "path", path, "field", field, "offset", message.Offset)
+			continue
+		}
+		// Partitions are consumed concurrently, so an older message may arrive after a newer one
+		if !message.Timestamp.IsZero() {
+			if message.Timestamp.Before(*latest) {
+				continue
+			}
+			*latest = message.Timestamp
+		}
+		if !b.current(path, es) {
+			return nil
+		}
+
+		// Something else may have been registered at the cache path since the stream was
+		if !b.index.Update(cachePath, cacheValue(value), isCacheValue) {
+			if !blocked {
+				log.Warn("Not caching last value over another registration", "path", path, "cache", cachePath)
+			}
+			blocked = true
+			continue
+		}
+		blocked = false
+		b.events.Publish(&pb.Event{Path: cachePath, Type: nc.EventRegistered, Data: []byte(nc.FormatData(value))})
+	}
+	return fmt.Errorf("event stream ended")
+}
+
+// current reports whether an event stream is still registered at its path, possibly again
+// with the same settings
+func (b *LastValueBridge) current(path string, es *pb.EventStream) bool {
+	registered, ok := b.index.ValueAt(path).(*pb.EventStream)
+	return ok && (registered == es || proto.Equal(registered, es))
+}
+
+// isCacheValue reports whether a node value is one a last value cache stores, and so may replace
+func isCacheValue(value interface{}) bool {
+	switch value.(type) {
+	case *pb.StringValue, *pb.IntValue, *pb.FloatValue, *pb.BoolValue:
+		return true
+	}
+	return false
+}
+
+// cacheValue converts a decoded field to the value a last value cache stores. Booleans and
+// numbers keep their type, except integers too large for an IntValue, which are kept as text
+// so they don't lose precision. Anything else is stored as formatted by FormatData.
+func cacheValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bool:
+		return &pb.BoolValue{Value: v}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return cacheInt(i)
+		}
+		if f, err := v.Float64(); err == nil && strings.ContainsAny(v.String(), ".eE") {
+			return &pb.FloatValue{Value: float32(f)}
+		}
+		return &pb.StringValue{Value: v.String()}
+	}
+
+	number := reflect.ValueOf(value)
+	switch number.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cacheInt(number.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if number.Uint() <= math.MaxInt32 {
+			return &pb.IntValue{Value: int32(number.Uint())}
+		}
+		return &pb.StringValue{Value: strconv.FormatUint(number.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return &pb.FloatValue{Value: float32(number.Float())}
+	}
+	return &pb.StringValue{Value: nc.FormatData(value)}
+}
+
+// cacheInt stores an integer as an IntValue if it fits, and as text otherwise
+func cacheInt(i int64) interface{} {
+	if i >= math.MinInt32 && i <= math.MaxInt32 {
+		return &pb.IntValue{Value: int32(i)}
+	}
+	return &pb.StringValue{Value: strconv.FormatInt(i, 10)}
+}
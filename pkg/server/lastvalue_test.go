@@ -0,0 +1,85 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "nexus/pkg/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCacheValue(t *testing.T) {
+	// Numbers that don't fit an int32 value are kept as strings rather than rounded
+	cases := map[interface{}]proto.Message{
+		true:                                &pb.BoolValue{Value: true},
+		json.Number("42"):                   &pb.IntValue{Value: 42},
+		json.Number("1.5"):                  &pb.FloatValue{Value: 1.5},
+		json.Number("1e3"):                  &pb.FloatValue{Value: 1000},
+		json.Number("12345678901234567890"): &pb.StringValue{Value: "12345678901234567890"},
+		int64(1) << 40:                      &pb.StringValue{Value: "1099511627776"},
+		int8(-3):                            &pb.IntValue{Value: -3},
+		uint64(7):                           &pb.IntValue{Value: 7},
+		0.25:                                &pb.FloatValue{Value: 0.25},
+		"up":                                &pb.StringValue{Value: "up"},
+		nil:                                 &pb.StringValue{Value: "null"},
+	}
+	for value, want := range cases {
+		if got, ok := cacheValue(value).(proto.Message); !ok || !proto.Equal(got, want) {
+			t.Errorf("cacheValue(%#v) = %v, want %v", value, got, want)
+		}
+	}
+	object := map[string]interface{}{"a": json.Number("1")}
+	if got := cacheValue(object); !proto.Equal(got.(proto.Message), &pb.StringValue{Value: `{"a":1}`}) {
+		t.Errorf("objects are cached as %v, want their JSON", got)
+	}
+}
+
+// TestLastValueBridge checks that the latest field of a stream's messages is kept typed in the
+// cache node
+func TestLastValueBridge(t *testing.T) {
+	server, client := newTestServer(t)
+	es := &pb.EventStream{
+		Transport: "inproc", Server: t.Name(), Topic: "prices", Encoding: "json",
+		LastValue: &pb.LastValueCache{Field: "price"},
+	}
+	if err := client.PublishEventStream("/prices", es); err != nil {
+		t.Fatal(err)
+	}
+
+	// The cache may subscribe after the first messages, but always catches the latest
+	deadline := time.Now().Add(5 * time.Second)
+	for price := 1; ; price++ {
+		if _, err := client.Produce("/prices", nil, []byte(fmt.Sprintf(`{"price": %d.5}`, price)), nil); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		node, err := server.Index.Snapshot("/prices/latest")
+		if err == nil && proto.Equal(node.Value.(proto.Message), &pb.FloatValue{Value: float32(price) + 0.5}) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("last value wasn't cached: %v", node)
+		}
+	}
+}
+
+// TestLastValueDenied checks that streams sending the server's credentials to hosts it may not
+// connect to can't be registered with a last value cache
+func TestLastValueDenied(t *testing.T) {
+	_, client := newTestServer(t)
+	es := &pb.EventStream{
+		Brokers: []string{"broker.example:9092"}, Topic: "prices", Encoding: "json",
+		Sasl:      &pb.KafkaSASL{CredentialRef: "env:KAFKA"},
+		LastValue: &pb.LastValueCache{Field: "price"},
+	}
+	if err := client.PublishEventStream("/prices", es); err == nil {
+		t.Fatal("registered a last value cache consuming a host that isn't allowed")
+	}
+	es.LastValue = nil
+	if err := client.PublishEventStream("/prices", es); err != nil {
+		t.Fatalf("stream without a cache wasn't registered: %v", err)
+	}
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"nexus/pkg/logger"
+	"sort"
+	"strings"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// SetUpstream declares the paths the node at path is derived from, replacing any declared
+// before. Upstream paths don't need to exist yet, but may not depend on path themselves.
+func (t *Trie) SetUpstream(path string, upstream []string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	path = cleanPath(path)
+	node := t.lookup(path)
+	if node == nil || !node.IsEndOfPath {
+		return fmt.Errorf("no value registered at path: %s", path)
+	}
+
+	cleaned := make([]string, 0, len(upstream))
+	seen := map[string]bool{}
+	for _, up := range upstream {
+		up = cleanPath(up)
+		if up == path {
+			return fmt.Errorf("path can't depend on itself: %s", path)
+		}
+		if seen[up] {
+			continue
+		}
+		if t.dependsOn(up, path) {
+			return fmt.Errorf("lineage cycle: %s already depends on %s", up, path)
+		}
+		seen[up] = true
+		cleaned = append(cleaned, up)
+	}
+	node.Upstream = cleaned
+	return nil
+}
+
+// dependsOn reports whether from is derived from to, directly or indirectly. The caller must
+// hold the lock.
+func (t *Trie) dependsOn(from string, to string) bool {
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		node := t.lookup(queue[0])
+		queue = queue[1:]
+		if node == nil {
+			continue
+		}
+		for _, up := range node.Upstream {
+			if up == to {
+				return true
+			}
+			if !visited[up] {
+				visited[up] = true
+				queue = append(queue, up)
+			}
+		}
+	}
+	return false
+}
+
+// downstreamIndex maps each path to the paths declaring it as upstream. The caller must hold
+// the lock.
+func (t *Trie) downstreamIndex() map[string][]string {
+	index := map[string][]string{}
+	walkHelper(t.Root, "", func(path string, node *TrieNode) {
+		for _, up := range node.Upstream {
+			index[up] = append(index[up], path)
+		}
+	})
+	for _, downstream := range index {
+		sort.Strings(downstream)
+	}
+	return index
+}
+
+// Dependents returns the paths derived, directly or indirectly, from path or any path below
+// it, leaving out those below path themselves
+func (t *Trie) Dependents(path string) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	path = cleanPath(path)
+	downstream := t.downstreamIndex()
+	visited := map[string]bool{}
+	var queue []string
+	for up := range downstream {
+		if isBelow(up, path) {
+			queue = append(queue, up)
+		}
+	}
+
+	var dependents []string
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, down := range downstream[current] {
+			if visited[down] {
+				continue
+			}
+			visited[down] = true
+			queue = append(queue, down)
+			if !isBelow(down, path) {
+				dependents = append(dependents, down)
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// Lineage returns the nodes and edges reached from path by following its dependencies in the
+// given direction, up to depth edges away or without limit if depth is zero
+func (t *Trie) Lineage(path string, direction string, depth int) ([]*pb.LineageNode, []*pb.LineageEdge, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	path = cleanPath(path)
+	if t.lookup(path) == nil {
+		return nil, nil, fmt.Errorf("path not found: %s", path)
+	}
+
+	var upstream, downstream bool
+	switch direction {
+	case "", nc.LineageBoth:
+		upstream, downstream = true, true
+	case nc.LineageUpstream:
+		upstream = true
+	case nc.LineageDownstream:
+		downstream = true
+	default:
+		return nil, nil, fmt.Errorf("invalid lineage direction: %q", direction)
+	}
+
+	nodes := []*pb.LineageNode{t.lineageNode(path, 0)}
+	var edges []*pb.LineageEdge
+	if upstream {
+		edges = t.followLineage(path, depth, -1, func(p string) []string {
+			if node := t.lookup(p); node != nil {
+				return node.Upstream
+			}
+			return nil
+		}, &nodes, edges)
+	}
+	if downstream {
+		index := t.downstreamIndex()
+		edges = t.followLineage(path, depth, 1, func(p string) []string {
+			return index[p]
+		}, &nodes, edges)
+	}
+	return nodes, edges, nil
+}
+
+// followLineage walks the lineage graph breadth first from path, appending the nodes it reaches
+// and returning edges with those it crossed added. sign is -1 walking upstream and 1 downstream.
+func (t *Trie) followLineage(path string, depth int, sign int, next func(string) []string, nodes *[]*pb.LineageNode, edges []*pb.LineageEdge) []*pb.LineageEdge {
+	visited := map[string]bool{path: true}
+	frontier := []string{path}
+	for distance := 1; len(frontier) > 0 && (depth <= 0 || distance <= depth); distance++ {
+		var nextFrontier []string
+		for _, current := range frontier {
+			for _, other := range next(current) {
+				if sign < 0 {
+					edges = append(edges, &pb.LineageEdge{Upstream: other, Downstream: current})
+				} else {
+					edges = append(edges, &pb.LineageEdge{Upstream: current, Downstream: other})
+				}
+				if visited[other] {
+					continue
+				}
+				visited[other] = true
+				*nodes = append(*nodes, t.lineageNode(other, sign*distance))
+				nextFrontier = append(nextFrontier, other)
+			}
+		}
+		frontier = nextFrontier
+	}
+	return edges
+}
+
+// lineageNode describes the node at a path for a lineage graph. The caller must hold the lock.
+func (t *Trie) lineageNode(path string, depth int) *pb.LineageNode {
+	node := t.lookup(path)
+	if node == nil {
+		return &pb.LineageNode{Path: path, Depth: int32(depth), Missing: true}
+	}
+	return &pb.LineageNode{Path: path, Type: node.ValueType, Depth: int32(depth), Health: nodeHealth(node)}
+}
+
+// isBelow reports whether path is dir or lies below it
+func isBelow(path string, dir string) bool {
+	return path == dir || dir == "/" || strings.HasPrefix(path, dir+"/")
+}
+
+// DeclareLineage implements the publisher endpoint for declaring the upstream dependencies of a node
+func (s *NexusServer) DeclareLineage(ctx context.Context, req *pb.DeclareLineageRequest) (*pb.DeclareLineageResponse, error) {
+	log := logger.GetLogger()
+	log.Info("Received lineage declaration", "path", req.Path, "upstream", req.Upstream)
+
+	if err := s.Index.SetUpstream(req.Path, req.Upstream); err != nil {
+		return &pb.DeclareLineageResponse{Success: false, Error: err.Error()}, nil
+	}
+	return &pb.DeclareLineageResponse{Success: true}, nil
+}
+
+// GetLineage implements the consumer endpoint returning the lineage graph around a node
+func (s *NexusServer) GetLineage(ctx context.Context, req *pb.GetLineageRequest) (*pb.GetLineageResponse, error) {
+	log := logger.GetLogger()
+	log.Info("Received request to get lineage", "path", req.Path, "direction", req.Direction, "depth", req.Depth)
+
+	nodes, edges, err := s.Index.Lineage(req.Path, req.Direction, int(req.Depth))
+	if err != nil {
+		return &pb.GetLineageResponse{Error: err.Error()}, nil
+	}
+	return &pb.GetLineageResponse{Nodes: nodes, Edges: edges}, nil
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	pb "nexus/pkg/proto"
+)
+
+// lineageTrie returns a trie where /raw <- /clean <- /report, with /other standing alone
+func lineageTrie(t *testing.T) *Trie {
+	t.Helper()
+	trie, err := NewTrie()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/raw", "/clean", "/report", "/other"} {
+		trie.Insert(path, &pb.StringValue{Value: path})
+	}
+	if err := trie.SetUpstream("/clean", []string{"/raw"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := trie.SetUpstream("/report", []string{"/clean"}); err != nil {
+		t.Fatal(err)
+	}
+	return trie
+}
+
+func TestSetUpstream(t *testing.T) {
+	trie := lineageTrie(t)
+	declarations := []struct {
+		path     string
+		upstream []string
+		want     []string
+	}{
+		{"/report", []string{"/other"}, []string{"/other"}},
+		// Paths are cleaned and deduplicated
+		{"/report", []string{"clean/", "/clean", "/raw"}, []string{"/clean", "/raw"}},
+		// Upstream paths may be registered later
+		{"/other", []string{"/later"}, []string{"/later"}},
+		{"/clean", nil, []string{}},
+	}
+	for _, declaration := range declarations {
+		if err := trie.SetUpstream(declaration.path, declaration.upstream); err != nil {
+			t.Fatalf("SetUpstream(%s, %v): %v", declaration.path, declaration.upstream, err)
+		}
+		node, err := trie.GetNode(declaration.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(node.Upstream, declaration.want) {
+			t.Errorf("upstream of %s = %v, want %v", declaration.path, node.Upstream, declaration.want)
+		}
+	}
+}
+
+func TestSetUpstreamRejected(t *testing.T) {
+	rejected := map[string][]string{
+		"/raw":     {"/other", "/report"}, // Indirect cycle
+		"/clean":   {"/report"},           // Direct cycle
+		"/report":  {"report"},            // Itself
+		"/missing": {"/raw"},              // Not registered
+	}
+	for path, upstream := range rejected {
+		trie := lineageTrie(t)
+		if err := trie.SetUpstream(path, upstream); err == nil {
+			t.Errorf("SetUpstream(%s, %v) succeeded", path, upstream)
+		}
+	}
+}
+
+func TestDeleteWithDependents(t *testing.T) {
+	_, client := newTestServer(t)
+	for _, path := range []string{"/raw", "/clean", "/report"} {
+		if err := client.PublishValue(path, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := client.DeclareLineage("/clean", "/raw"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.DeclareLineage("/report", "/clean"); err != nil {
+		t.Fatal(err)
+	}
+
+	dependents, err := client.DeleteWithDependents("/raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(dependents)
+	if !reflect.DeepEqual(dependents, []string{"/clean", "/report"}) {
+		t.Errorf("dependents of the deleted path = %v, want /clean and /report", dependents)
+	}
+
+	if err := client.Delete("/report"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Get("/report"); err == nil {
+		t.Error("deleted path is still registered")
+	}
+}
@@ -0,0 +1,292 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"nexus/pkg/logger"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+const (
+	DefaultPreviewRows            = 20
+	DefaultPreviewMaxScanRows     = 1000000
+	DefaultPreviewRefreshInterval = 10 * time.Minute
+	previewPollInterval           = 30 * time.Second
+)
+
+// PreviewCache computes and caches the first rows and column statistics of registered
+// datasets. File and directory previews are recomputed when their files change, database
+// table and query previews once RefreshInterval has passed.
+type PreviewCache struct {
+	Rows            int           // Number of rows in each preview
+	MaxScanRows     int64         // Maximum number of rows statistics are computed over
+	RefreshInterval time.Duration // Age after which database previews are recomputed
+
+	index     *Trie
+	access    *DatasetAccess
+	mu        sync.Mutex
+	entries   map[string]*previewEntry
+	computing map[string]*previewCall // Previews being computed, by path
+}
+
+type previewEntry struct {
+	value    interface{} // Node value the preview was computed for
+	modTime  time.Time   // Latest modification time of the source files, zero for databases
+	computed time.Time
+	preview  *pb.GetPreviewResponse
+}
+
+// previewCall is a preview being computed, which requests for the same preview wait for
+// rather than reading the dataset again
+type previewCall struct {
+	value   interface{}
+	modTime time.Time
+	done    chan struct{} // Closed once preview is set
+	preview *pb.GetPreviewResponse
+}
+
+// NewPreviewCache creates a preview cache for the datasets registered in an index, previewing
+// only those access allows the server to open
+func NewPreviewCache(index *Trie, access *DatasetAccess) *PreviewCache {
+	return &PreviewCache{
+		Rows:            DefaultPreviewRows,
+		MaxScanRows:     DefaultPreviewMaxScanRows,
+		RefreshInterval: DefaultPreviewRefreshInterval,
+		index:           index,
+		access:          access,
+		entries:         map[string]*previewEntry{},
+		computing:       map[string]*previewCall{},
+	}
+}
+
+// Start refreshes stale previews of every registered dataset in the background
+func (c *PreviewCache) Start() {
+	log := logger.GetLogger()
+	log.Info("Starting preview cache", "rows", c.Rows, "refresh_interval", c.RefreshInterval)
+	go func() {
+		for {
+			c.refreshAll()
+			time.Sleep(previewPollInterval)
+		}
+	}()
+}
+
+// Get returns the preview of the dataset at a path, computing it if it isn't cached, is stale
+// or refresh is set. Requests join a computation of the same preview already running. If ctx
+// ends before the preview is computed, the previous preview is returned if there is one, and
+// the computation goes on to cache its result.
+func (c *PreviewCache) Get(ctx context.Context, path string, refresh bool) (*pb.GetPreviewResponse, error) {
+	node, err := c.index.Snapshot(path)
+	if err != nil {
+		return nil, err
+	}
+	if !isDataset(node.Value) {
+		return nil, fmt.Errorf("no dataset found at path: %s", path)
+	}
+
+	key := cleanPath(path)
+	modTime := sourceModTime(node.Value)
+	c.mu.Lock()
+	entry := c.entries[key]
+	c.mu.Unlock()
+	if !refresh && entry != nil && !c.stale(entry, node.Value, modTime) {
+		return entry.preview, nil
+	}
+
+	call := c.start(key, node.Value, modTime)
+	select {
+	case <-call.done:
+		return call.preview, nil
+	case <-ctx.Done():
+		if entry != nil {
+			return entry.preview, nil
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// start computes the preview of a dataset in the background, unless it is already being
+// computed for the same value and files
+func (c *PreviewCache) start(path string, value interface{}, modTime time.Time) *previewCall {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if call := c.computing[path]; call != nil && call.value == value && call.modTime.Equal(modTime) {
+		return call
+	}
+
+	call := &previewCall{value: value, modTime: modTime, done: make(chan struct{})}
+	c.computing[path] = call
+	go func() {
+		call.preview = c.compute(path, value, modTime)
+		c.mu.Lock()
+		if c.computing[path] == call {
+			delete(c.computing, path)
+		}
+		c.mu.Unlock()
+		close(call.done)
+	}()
+	return call
+}
+
+// refreshAll recomputes stale previews and drops those of datasets no longer registered
+func (c *PreviewCache) refreshAll() {
+	log := logger.GetLogger()
+
+	datasets := map[string]interface{}{}
+	c.index.Walk(func(path string, node *TrieNode) {
+		if isDataset(node.Value) {
+			datasets[path] = node.Value
+		}
+	})
+
+	c.mu.Lock()
+	for path := range c.entries {
+		if _, ok := datasets[path]; !ok {
+			delete(c.entries, path)
+		}
+	}
+	c.mu.Unlock()
+
+	for path, value := range datasets {
+		modTime := sourceModTime(value)
+		c.mu.Lock()
+		entry := c.entries[path]
+		c.mu.Unlock()
+		if entry == nil || c.stale(entry, value, modTime) {
+			log.Debug("Refreshing preview", "path", path)
+			<-c.start(path, value, modTime).done
+		}
+	}
+}
+
+func (c *PreviewCache) stale(entry *previewEntry, value interface{}, modTime time.Time) bool {
+	if entry.value != value || !entry.modTime.Equal(modTime) {
+		return true
+	}
+	return modTime.IsZero() && time.Since(entry.computed) >= c.RefreshInterval
+}
+
+// compute reads a dataset to build its preview and caches the result. Failures are cached
+// too, with the error in the preview, so unreadable datasets aren't retried on every poll.
+func (c *PreviewCache) compute(path string, value interface{}, modTime time.Time) *pb.GetPreviewResponse {
+	log := logger.GetLogger()
+	started := time.Now()
+
+	preview, err := c.computePreview(value)
+	if err != nil {
+		log.Error("Failed to compute preview", "path", path, "error", err)
+		preview = &pb.GetPreviewResponse{Error: err.Error()}
+	}
+	preview.ComputedAt = started.Unix()
+	log.Debug("Computed preview", "path", path, "rows_scanned", preview.RowsScanned, "duration", time.Since(started))
+
+	c.mu.Lock()
+	c.entries[path] = &previewEntry{value: value, modTime: modTime, computed: started, preview: preview}
+	c.mu.Unlock()
+	return preview
+}
+
+func (c *PreviewCache) computePreview(value interface{}) (*pb.GetPreviewResponse, error) {
+	cursor, err := openDatasetValue(c.index, c.access, value, nc.ReadOptions{}, nc.DirectoryReadOptions{})
+	if _, ok := value.(*pb.Directory); ok && err != nil {
+		// Directories holding only hive-style partition subdirectories have no top-level files
+		cursor, err = openDatasetValue(c.index, c.access, value, nc.ReadOptions{}, nc.DirectoryReadOptions{Partitioned: true})
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	columns := cursor.Columns()
+	stats := make([]*columnStats, len(columns))
+	for i, column := range columns {
+		stats[i] = newColumnStats(column.Name)
+	}
+
+	preview := &pb.GetPreviewResponse{Columns: nc.ColumnsToProto(columns), Complete: true}
+	for {
+		batch, err := cursor.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range batch {
+			if c.MaxScanRows > 0 && preview.RowsScanned >= c.MaxScanRows {
+				preview.Complete = false
+				break
+			}
+			if len(preview.Rows) < c.Rows {
+				preview.Rows = append(preview.Rows, nc.RowToProto(row))
+			}
+			for i, value := range row {
+				stats[i].add(value)
+			}
+			preview.RowsScanned++
+		}
+		if !preview.Complete {
+			break
+		}
+	}
+
+	for _, column := range stats {
+		preview.Stats = append(preview.Stats, column.proto())
+	}
+	return preview, nil
+}
+
+// isDataset reports whether a node value can be read as a table
+func isDataset(value interface{}) bool {
+	switch value.(type) {
+	case *pb.IndividualFile, *pb.Directory, *pb.DatabaseTable, *pb.Query:
+		return true
+	}
+	return false
+}
+
+// sourceModTime returns the latest modification time of the files behind a file or directory
+// dataset, or the zero time for other datasets and files that can't be read
+func sourceModTime(value interface{}) time.Time {
+	switch v := value.(type) {
+	case *pb.IndividualFile:
+		info, err := os.Stat(v.FilePath)
+		if err != nil {
+			return time.Time{}
+		}
+		return info.ModTime()
+	case *pb.Directory:
+		var latest time.Time
+		filepath.WalkDir(v.DirectoryPath, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			if info, err := entry.Info(); err == nil && info.ModTime().After(latest) {
+				latest = info.ModTime()
+			}
+			return nil
+		})
+		return latest
+	}
+	return time.Time{}
+}
+
+// GetPreview implements the consumer endpoint returning the cached preview of a dataset
+func (s *NexusServer) GetPreview(ctx context.Context, req *pb.GetPreviewRequest) (*pb.GetPreviewResponse, error) {
+	log := logger.GetLogger()
+	log.Info("Received request to get preview", "path", req.Path, "refresh", req.Refresh)
+
+	preview, err := s.Previews.Get(ctx, req.Path, req.Refresh)
+	if err != nil {
+		return &pb.GetPreviewResponse{Error: err.Error()}, nil
+	}
+	return preview, nil
+}
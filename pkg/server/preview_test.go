@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	nc "nexus/pkg/client"
+)
+
+// gatedReader holds files open until released, counting how often they are opened
+type gatedReader struct {
+	release chan struct{}
+	opens   atomic.Int32
+}
+
+func (r *gatedReader) Open(filePath string) (nc.RowSource, error) {
+	r.opens.Add(1)
+	<-r.release
+	table := &nc.Table{Columns: []nc.Column{{Name: "id", Type: nc.TypeInt}}, Rows: [][]interface{}{{int64(1)}, {int64(2)}}}
+	return nc.NewTableSource(table), nil
+}
+
+func TestPreview(t *testing.T) {
+	dir := resolved(t, t.TempDir())
+	filePath := filepath.Join(dir, "scores.csv")
+	if err := os.WriteFile(filePath, []byte("name,score\na,1\nb,\nc,3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	server, client := newTestServer(t, dir)
+	server.Previews.Rows = 2
+	if err := client.PublishIndividualFile("/scores", nc.CreateIndividualFile(filePath)); err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := client.GetPreview("/scores", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preview.Rows) != 2 || preview.RowsScanned != 3 || !preview.Complete {
+		t.Fatalf("preview has %d rows over %d scanned, complete %v", len(preview.Rows), preview.RowsScanned, preview.Complete)
+	}
+	if score := preview.Stats[1]; score.Name != "score" || score.NullCount != 1 {
+		t.Errorf("score stats = %v, want one null", score)
+	}
+
+	// Previews follow the file once it changes
+	if err := os.WriteFile(filePath, []byte("name,score\na,1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filePath, later, later); err != nil {
+		t.Fatal(err)
+	}
+	preview, err = client.GetPreview("/scores", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.RowsScanned != 1 {
+		t.Errorf("preview of the changed file scanned %d rows, want 1", preview.RowsScanned)
+	}
+}
+
+func TestPreviewDenied(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "scores.csv")
+	if err := os.WriteFile(filePath, []byte("name,score\na,1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, client := newTestServer(t)
+	if err := client.PublishIndividualFile("/scores", nc.CreateIndividualFile(filePath)); err != nil {
+		t.Fatal(err)
+	}
+	if preview, err := client.GetPreview("/scores", false); err == nil {
+		t.Errorf("previewed a file outside the roots: %v", preview)
+	}
+}
+
+// TestPreviewWait checks that requests stop waiting for a slow preview when their context ends,
+// and that concurrent requests share one computation of it
+func TestPreviewWait(t *testing.T) {
+	reader := &gatedReader{release: make(chan struct{})}
+	nc.RegisterReader("gated", reader)
+	dir := resolved(t, t.TempDir())
+	filePath := filepath.Join(dir, "slow.gated")
+	if err := os.WriteFile(filePath, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	server, _ := newTestServer(t, dir)
+	server.Index.Insert("/slow", nc.CreateIndividualFile(filePath))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := server.Previews.Get(ctx, "/slow", false); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("preview not computed in time returned %v, want the context's error", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			preview, err := server.Previews.Get(context.Background(), "/slow", false)
+			if err != nil || preview.RowsScanned != 2 {
+				t.Errorf("preview = %v, %v, want 2 rows scanned", preview, err)
+			}
+		}()
+	}
+	close(reader.release)
+	wg.Wait()
+	if opens := reader.opens.Load(); opens != 1 {
+		t.Errorf("file opened %d times for concurrent previews, want once", opens)
+	}
+}
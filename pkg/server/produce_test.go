@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// TestProduce produces to a registered in-process stream in both modes and reads the messages
+// back with a direct subscription
+func TestProduce(t *testing.T) {
+	_, client := newTestServer(t)
+	es := &pb.EventStream{Transport: nc.TransportInProcess, Server: t.Name(), Topic: "orders"}
+	if err := client.PublishEventStream("/orders", es); err != nil {
+		t.Fatal(err)
+	}
+
+	headers := []nc.Header{{Key: "source", Value: []byte("test")}}
+	delivery, err := client.Produce("/orders", []byte("k"), []byte("first"), headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Path != "/orders" || delivery.Topic != "orders" {
+		t.Errorf("delivery = %+v, want orders at /orders", delivery)
+	}
+	first := delivery.Offset
+
+	var mu sync.Mutex
+	var reported []nc.Delivery
+	options := nc.ProducerOptions{Mode: nc.ProduceAsync, OnDelivery: func(delivery nc.Delivery) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported = append(reported, delivery)
+	}}
+	if err := client.SetProducerOptions(options); err != nil {
+		t.Fatal(err)
+	}
+	delivery, err = client.Produce("/orders", nil, []byte("second"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Offset != -1 {
+		t.Errorf("async delivery has offset %d before it was reported", delivery.Offset)
+	}
+	client.Flush()
+	mu.Lock()
+	if len(reported) != 1 || reported[0].Offset != first+1 || reported[0].Err != nil {
+		t.Errorf("reported %+v, want offset %d delivered", reported, first+1)
+	}
+	mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	subscription, err := nc.SubscribeEventStream(ctx, es, nc.StreamOptions{Offsets: map[int32]int64{0: first}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscription.Close()
+	for _, want := range []string{"first", "second"} {
+		select {
+		case message := <-subscription.Messages():
+			if string(message.Value) != want {
+				t.Errorf("value = %q, want %q", message.Value, want)
+			}
+			if want == "first" && (string(message.Key) != "k" || len(message.Headers) != 1) {
+				t.Errorf("key and headers not kept: %+v", message)
+			}
+		case <-ctx.Done():
+			t.Fatalf("%q not received", want)
+		}
+	}
+}
+
+func TestProduceErrors(t *testing.T) {
+	_, client := newTestServer(t)
+	if err := client.PublishValue("/setting", "on"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Produce("/setting", nil, []byte("x"), nil); err == nil {
+		t.Error("produced to a path that isn't an event stream")
+	}
+	if _, err := client.Produce("/missing", nil, []byte("x"), nil); err == nil {
+		t.Error("produced to a path that isn't registered")
+	}
+
+	invalid := []nc.ProducerOptions{
+		{Mode: "eventually"},
+		{BatchSize: -1},
+		{Linger: -time.Second},
+	}
+	for _, options := range invalid {
+		if err := client.SetProducerOptions(options); err == nil {
+			t.Errorf("producer options %+v accepted", options)
+		}
+	}
+}
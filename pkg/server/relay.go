@@ -0,0 +1,288 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"nexus/pkg/logger"
+	"slices"
+	"sync"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// relayBuffer is the number of messages buffered for each subscriber of a shared relay before
+// the subscriber is considered too slow and dropped
+const relayBuffer = 256
+
+// RelayHub consumes event streams on behalf of gRPC clients. Clients tailing the same partitions
+// of a stream share one upstream consumer, while clients starting elsewhere or joining a
+// consumer group get their own, which only reads as fast as the client receives.
+type RelayHub struct {
+	mu        sync.Mutex
+	upstreams map[string]*upstream // Shared upstream consumers, by stream path and partitions
+}
+
+// upstream is a subscription to an event stream whose messages are fanned out to subscribers
+type upstream struct {
+	key          string
+	shared       bool
+	subscription *nc.Subscription
+
+	mu          sync.Mutex
+	subscribers map[*RelaySubscriber]struct{}
+	closing     bool // The last subscriber left, so no more may join
+}
+
+// RelaySubscriber receives the messages of a relayed event stream
+type RelaySubscriber struct {
+	messages chan *pb.RelayMessage
+	gone     chan struct{} // Closed once the subscriber stops receiving
+	err      error         // Why the upstream stopped sending, read after messages is closed
+}
+
+// NewRelayHub creates a relay hub without upstream consumers
+func NewRelayHub() *RelayHub {
+	return &RelayHub{upstreams: map[string]*upstream{}}
+}
+
+// Subscribe starts receiving the messages of an event stream, joining a shared upstream if the
+// options allow it. The returned function ends the subscription, closing its upstream once no
+// subscribers are left.
+func (h *RelayHub) Subscribe(path string, es *pb.EventStream, options nc.StreamOptions) (*RelaySubscriber, func(), error) {
+	if !options.Shareable(es) {
+		up, err := newUpstream("", path, es, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		sub := &RelaySubscriber{messages: make(chan *pb.RelayMessage), gone: make(chan struct{})}
+		up.subscribers[sub] = struct{}{}
+		go h.fanOut(up)
+		return sub, func() { h.unsubscribe(up, sub) }, nil
+	}
+
+	partitions := slices.Clone(options.Partitions)
+	slices.Sort(partitions)
+	key := fmt.Sprintf("%s %v", path, partitions)
+	sub := &RelaySubscriber{messages: make(chan *pb.RelayMessage, relayBuffer), gone: make(chan struct{})}
+
+	// Held while a new upstream connects, so that concurrent subscribers share it
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if up := h.upstreams[key]; up != nil {
+		up.mu.Lock()
+		joined := !up.closing
+		if joined {
+			up.subscribers[sub] = struct{}{}
+		}
+		up.mu.Unlock()
+		if joined {
+			return sub, func() { h.unsubscribe(up, sub) }, nil
+		}
+	}
+
+	up, err := newUpstream(key, path, es, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	up.subscribers[sub] = struct{}{}
+	h.upstreams[key] = up
+	go h.fanOut(up)
+	return sub, func() { h.unsubscribe(up, sub) }, nil
+}
+
+// newUpstream subscribes to an event stream for relaying, shared under key if it is set
+func newUpstream(key string, path string, es *pb.EventStream, options nc.StreamOptions) (*upstream, error) {
+	// Closed by the last subscriber to leave, rather than with the request that started it
+	subscription, err := nc.SubscribeEventStream(context.Background(), es, options)
+	if err != nil {
+		return nil, err
+	}
+	logger.GetLogger().Info("Started event stream relay", "path", path, "shared", key != "")
+	return &upstream{
+		key:          key,
+		shared:       key != "",
+		subscription: subscription,
+		subscribers:  map[*RelaySubscriber]struct{}{},
+	}, nil
+}
+
+// Messages returns the channel messages and errors are delivered on, closed when the upstream
+// stops sending to the subscriber
+func (s *RelaySubscriber) Messages() <-chan *pb.RelayMessage {
+	return s.messages
+}
+
+// Err returns why the upstream stopped sending, once the message channel is closed
+func (s *RelaySubscriber) Err() error {
+	return s.err
+}
+
+// unsubscribe removes a subscriber from its upstream, closing the upstream if it was the last
+func (h *RelayHub) unsubscribe(up *upstream, sub *RelaySubscriber) {
+	close(sub.gone)
+
+	up.mu.Lock()
+	delete(up.subscribers, sub)
+	last := len(up.subscribers) == 0 && !up.closing
+	if last {
+		up.closing = true
+	}
+	up.mu.Unlock()
+	if !last {
+		return
+	}
+
+	h.forget(up)
+	logger.GetLogger().Debug("Closing event stream relay", "key", up.key)
+	go up.subscription.Close()
+}
+
+// forget removes a shared upstream from the hub, so that new subscribers start another
+func (h *RelayHub) forget(up *upstream) {
+	if !up.shared {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.upstreams[up.key] == up {
+		delete(h.upstreams, up.key)
+	}
+}
+
+// fanOut sends the messages and errors of an upstream to its subscribers until it ends
+func (h *RelayHub) fanOut(up *upstream) {
+	messages, errors := up.subscription.Messages(), up.subscription.Errors()
+	for messages != nil {
+		var res *pb.RelayMessage
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			res = nc.MessageToProto(message)
+		case err, ok := <-errors:
+			if !ok {
+				errors = nil
+				continue
+			}
+			res = &pb.RelayMessage{Error: err.Error()}
+		}
+		up.send(res)
+	}
+
+	h.forget(up)
+	up.mu.Lock()
+	defer up.mu.Unlock()
+	up.closing = true
+	for sub := range up.subscribers {
+		sub.err = fmt.Errorf("event stream consumer stopped")
+		delete(up.subscribers, sub)
+		close(sub.messages)
+	}
+}
+
+// send passes a message to every subscriber of an upstream. Subscribers of a shared upstream
+// whose buffer is full are dropped, so that they don't hold up the others, while the single
+// subscriber of any other upstream is waited for.
+func (up *upstream) send(res *pb.RelayMessage) {
+	up.mu.Lock()
+	subscribers := make([]*RelaySubscriber, 0, len(up.subscribers))
+	for sub := range up.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	up.mu.Unlock()
+
+	for _, sub := range subscribers {
+		if !up.shared {
+			select {
+			case sub.messages <- res:
+			case <-sub.gone:
+			}
+			continue
+		}
+		select {
+		case sub.messages <- res:
+		case <-sub.gone:
+		default:
+			logger.GetLogger().Warn("Dropping slow event stream relay subscriber", "key", up.key)
+			up.mu.Lock()
+			if _, ok := up.subscribers[sub]; ok {
+				sub.err = fmt.Errorf("fell more than %d messages behind the event stream", relayBuffer)
+				delete(up.subscribers, sub)
+				close(sub.messages)
+			}
+			up.mu.Unlock()
+		}
+	}
+}
+
+// relayOptions returns the options a relay request consumes a stream with. Consumer groups
+// commit offsets as the server, so callers may only name a group listed in $NEXUS_RELAY_GROUPS,
+// and the stream's default group is only joined if it is listed, the stream being tailed
+// without a group otherwise.
+func relayOptions(access *DatasetAccess, es *pb.EventStream, req *pb.RelayEventStreamRequest) (nc.StreamOptions, error) {
+	options := nc.StreamOptionsFromProto(req)
+	if options.GroupID != "" {
+		if !slices.Contains(access.Groups, options.GroupID) {
+			return options, fmt.Errorf("consumer group %s may not be relayed: not in $%s", options.GroupID, RelayGroupsEnv)
+		}
+		return options, nil
+	}
+	if es.DefaultGroupId != "" && !slices.Contains(access.Groups, es.DefaultGroupId) {
+		options.Standalone = true
+	}
+	return options, nil
+}
+
+// RelayEventStream implements the event stream relay endpoint, consuming the registered topic
+// and streaming its messages to the client until it disconnects
+func (s *NexusServer) RelayEventStream(req *pb.RelayEventStreamRequest, stream pb.NexusService_RelayEventStreamServer) error {
+	log := logger.GetLogger()
+	log.Info("Received event stream relay request", "path", req.Path)
+
+	node, err := s.Index.Snapshot(req.Path)
+	if err != nil {
+		return stream.Send(&pb.RelayMessage{Error: err.Error()})
+	}
+	es, ok := node.Value.(*pb.EventStream)
+	if !ok {
+		return stream.Send(&pb.RelayMessage{Error: fmt.Sprintf("no event stream found at path: %s", req.Path)})
+	}
+
+	if err := s.Access.Check(es); err != nil {
+		log.Warn("Event stream relay denied", "path", req.Path, "error", err)
+		return stream.Send(&pb.RelayMessage{Error: err.Error()})
+	}
+	options, err := relayOptions(s.Access, es, req)
+	if err != nil {
+		log.Warn("Event stream relay denied", "path", req.Path, "error", err)
+		return stream.Send(&pb.RelayMessage{Error: err.Error()})
+	}
+
+	sub, unsubscribe, err := s.Relays.Subscribe(req.Path, es, options)
+	if err != nil {
+		log.Error("Failed to relay event stream", "path", req.Path, "error", err)
+		return stream.Send(&pb.RelayMessage{Error: err.Error()})
+	}
+	defer unsubscribe()
+	if err := stream.Send(&pb.RelayMessage{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case res, ok := <-sub.Messages():
+			if !ok {
+				log.Info("Event stream relay ended", "path", req.Path, "reason", sub.Err())
+				return stream.Send(&pb.RelayMessage{Error: sub.Err().Error()})
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// TestRelayFanOut checks that clients tailing a stream share one upstream consumer and each
+// receive every message in order
+func TestRelayFanOut(t *testing.T) {
+	server, client := newTestServer(t)
+	es := &pb.EventStream{Transport: "inproc", Server: t.Name(), Topic: "orders"}
+	if err := client.PublishEventStream("/orders", es); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var subscriptions []*nc.Subscription
+	for i := 0; i < 2; i++ {
+		subscription, err := client.RelayEventStream(ctx, "/orders", nc.StreamOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer subscription.Close()
+		subscriptions = append(subscriptions, subscription)
+	}
+	server.Relays.mu.Lock()
+	upstreams := len(server.Relays.upstreams)
+	server.Relays.mu.Unlock()
+	if upstreams != 1 {
+		t.Fatalf("%d upstream consumers for two tailing clients, want one", upstreams)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Produce("/orders", nil, []byte(fmt.Sprint(i)), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for n, subscription := range subscriptions {
+		for i := 0; i < 3; i++ {
+			select {
+			case message := <-subscription.Messages():
+				if string(message.Value) != fmt.Sprint(i) {
+					t.Fatalf("subscriber %d got %q, want %d", n, message.Value, i)
+				}
+			case err := <-subscription.Errors():
+				t.Fatalf("subscriber %d: %v", n, err)
+			case <-ctx.Done():
+				t.Fatalf("subscriber %d only got %d messages", n, i)
+			}
+		}
+	}
+}
+
+func TestRelayDenied(t *testing.T) {
+	_, client := newTestServer(t)
+	streams := map[string]*pb.EventStream{
+		"/secured": {Brokers: []string{"broker.example:9092"}, Topic: "t", Sasl: &pb.KafkaSASL{CredentialRef: "env:KAFKA"}},
+		"/grouped": {Transport: "inproc", Server: t.Name(), Topic: "t"},
+	}
+	for path, es := range streams {
+		if err := client.PublishEventStream(path, es); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.RelayEventStream(context.Background(), "/secured", nc.StreamOptions{}); err == nil {
+		t.Error("relayed a stream with server credentials to a host that isn't allowed")
+	}
+	if _, err := client.RelayEventStream(context.Background(), "/grouped", nc.StreamOptions{GroupID: "billing"}); err == nil {
+		t.Error("joined a consumer group that isn't allowed")
+	}
+}
+
+func TestRelayOptions(t *testing.T) {
+	access := &DatasetAccess{Groups: []string{"dashboards"}}
+
+	options, err := relayOptions(access, &pb.EventStream{}, &pb.RelayEventStreamRequest{GroupId: "dashboards"})
+	if err != nil || options.GroupID != "dashboards" {
+		t.Errorf("allowed group gave %+v, %v", options, err)
+	}
+	if _, err := relayOptions(access, &pb.EventStream{}, &pb.RelayEventStreamRequest{GroupId: "billing"}); err == nil {
+		t.Error("group not in the allowed list was accepted")
+	}
+
+	// A default group that isn't allowed is skipped rather than failing the relay
+	options, err = relayOptions(access, &pb.EventStream{DefaultGroupId: "billing"}, &pb.RelayEventStreamRequest{})
+	if err != nil || !options.Standalone {
+		t.Errorf("default group not allowed gave %+v, %v, want a standalone consumer", options, err)
+	}
+	options, err = relayOptions(access, &pb.EventStream{DefaultGroupId: "dashboards"}, &pb.RelayEventStreamRequest{})
+	if err != nil || options.Standalone {
+		t.Errorf("allowed default group gave %+v, %v, want it joined", options, err)
+	}
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	nc "nexus/pkg/client"
 	"nexus/pkg/logger"
 	pb "nexus/pkg/proto"
 	"sort"
@@ -14,7 +15,13 @@ const (
 // nexusServer implements the NexusService gRPC service
 type NexusServer struct {
 	pb.UnimplementedNexusServiceServer
-	Index *Trie
+	Index      *Trie
+	Previews   *PreviewCache
+	Health     *HealthChecker
+	Events     *EventHub
+	Relays     *RelayHub
+	LastValues *LastValueBridge
+	Access     *DatasetAccess // Datasets the server may open itself
 }
 
 // NewServer creates a new NexusServer instance
@@ -35,7 +42,17 @@ func NewServer(loadPath string) (*NexusServer, error) {
 		return nil, err
 	}
 
-	return &NexusServer{Index: index}, nil
+	events := NewEventHub()
+	access := DatasetAccessFromEnv()
+	return &NexusServer{
+		Index:      index,
+		Previews:   NewPreviewCache(index, access),
+		Health:     NewHealthChecker(index, events, access),
+		Events:     events,
+		Relays:     NewRelayHub(),
+		LastValues: NewLastValueBridge(index, events, access),
+		Access:     access,
+	}, nil
 }
 
 // SaveIndex saves the server's index to disk
@@ -48,9 +65,16 @@ func (s *NexusServer) RegisterEventStream(ctx context.Context, req *pb.RegisterE
 	log := logger.GetLogger()
 	log.Info("Received event stream registration request", "path", req.Path, "topic", req.EventStream.Topic)
 
+	if err := validateLastValue(s.Index, s.Access, req.Path, req.EventStream); err != nil {
+		return &pb.RegisterEventStreamResponse{Success: false, Error: err.Error()}, nil
+	}
+
 	// Insert the event stream into the Trie
 	s.Index.Insert(req.Path, req.EventStream) // Store the EventStream
 	s.Index.Traverse()                        // Print the Trie after the update
+	s.Health.Notify()                         // Check the new resource without waiting for the next round
+	s.LastValues.Update(req.Path, req.EventStream)
+	s.Events.Publish(&pb.Event{Path: req.Path, Type: nc.EventRegistered})
 
 	return &pb.RegisterEventStreamResponse{Success: true}, nil
 }
@@ -68,11 +92,14 @@ func (s *NexusServer) StoreValue(ctx context.Context, req *pb.StoreValueRequest)
 		s.Index.Insert(req.Path, req.GetIntValue())
 	case *pb.StoreValueRequest_FloatValue:
 		s.Index.Insert(req.Path, req.GetFloatValue())
+	case *pb.StoreValueRequest_BoolValue:
+		s.Index.Insert(req.Path, req.GetBoolValue())
 	default:
 		return &pb.StoreValueResponse{Success: false, Error: "invalid value type"}, nil
 	}
 
 	//s.Index.Traverse() // Print the Trie after the update
+	s.Events.Publish(&pb.Event{Path: req.Path, Type: nc.EventRegistered})
 	return &pb.StoreValueResponse{Success: true}, nil
 }
 
@@ -81,10 +108,18 @@ func (s *NexusServer) DeletePath(ctx context.Context, req *pb.DeletePathRequest)
 	log := logger.GetLogger()
 	log.Info("Received delete path request", "path", req.Path)
 
-	s.Index.Delete(req.Path)
+	// Look up the dependents first, as deleting the path removes its own dependencies
+	dependents := s.Index.Dependents(req.Path)
+	s.LastValues.Stop(req.Path) // So the caches of deleted streams don't recreate their values
+	if deleted, _ := s.Index.Delete(req.Path); deleted {
+		s.Events.Publish(&pb.Event{Path: req.Path, Type: nc.EventDeleted})
+		if len(dependents) > 0 {
+			log.Warn("Deleted path has dependents", "path", req.Path, "dependents", dependents)
+		}
+	}
 	//s.Index.Traverse() // Print the Trie after the update
 
-	return &pb.DeletePathResponse{Success: true}, nil
+	return &pb.DeletePathResponse{Success: true, Dependents: dependents}, nil
 }
 
 // GetNode implements the consumer endpoint for getting node information
@@ -92,11 +127,12 @@ func (s *NexusServer) GetNode(ctx context.Context, req *pb.GetPathRequest) (*pb.
 	log := logger.GetLogger()
 	log.Info("Received request to get node", "path", req.Path)
 
-	node, err := s.Index.GetNode(req.Path)
+	node, err := s.Index.Snapshot(req.Path)
 	if err != nil {
 		return &pb.GetNodeResponse{Error: err.Error()}, nil
 	}
 
+	health := node.Health
 	if node.ValueType == "InternalNode" {
 		log.Printf("InternalNode at path: %s\n", req.Path)
 		return &pb.GetNodeResponse{Value: nil, ValueType: node.ValueType}, nil
@@ -105,26 +141,32 @@ func (s *NexusServer) GetNode(ctx context.Context, req *pb.GetPathRequest) (*pb.
 	switch v := node.Value.(type) {
 	case *pb.StringValue:
 		log.Info("StringValue found at path", "path", req.Path)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_StringValue{StringValue: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_StringValue{StringValue: v}, ValueType: node.ValueType, Health: health}, nil
 	case *pb.IntValue:
 		log.Info("IntValue found at path", "path", req.Path)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_IntValue{IntValue: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_IntValue{IntValue: v}, ValueType: node.ValueType, Health: health}, nil
 	case *pb.FloatValue:
 		log.Info("FloatValue found at path", "path", req.Path)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_FloatValue{FloatValue: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_FloatValue{FloatValue: v}, ValueType: node.ValueType, Health: health}, nil
+	case *pb.BoolValue:
+		log.Info("BoolValue found at path", "path", req.Path)
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_BoolValue{BoolValue: v}, ValueType: node.ValueType, Health: health}, nil
 	case *pb.DatabaseTable:
 		log.Info("DatabaseTable found at path", "path", req.Path)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_DatabaseTable{DatabaseTable: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_DatabaseTable{DatabaseTable: v}, ValueType: node.ValueType, Health: health}, nil
+	case *pb.Query:
+		log.Info("Query found at path", "path", req.Path)
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_Query{Query: v}, ValueType: node.ValueType, Health: health}, nil
 	case *pb.Directory:
 		log.Info("Directory found at path", "path", req.Path)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_Directory{Directory: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_Directory{Directory: v}, ValueType: node.ValueType, Health: health}, nil
 	case *pb.IndividualFile:
 		log.Info("IndividualFile found at path", "path", req.Path)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_IndividualFile{IndividualFile: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_IndividualFile{IndividualFile: v}, ValueType: node.ValueType, Health: health}, nil
 	case *pb.EventStream:
 		log.Info("EventStream found at path", "path", req.Path)
 		log.Info("EventStream", "node", v)
-		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_EventStream{EventStream: v}, ValueType: node.ValueType}, nil
+		return &pb.GetNodeResponse{Value: &pb.GetNodeResponse_EventStream{EventStream: v}, ValueType: node.ValueType, Health: health}, nil
 	default:
 		log.Error("Unknown value type", "type", v)
 		return &pb.GetNodeResponse{Error: "unknown value type"}, nil
@@ -223,6 +265,8 @@ func (s *NexusServer) RegisterFile(ctx context.Context, req *pb.RegisterFileRequ
 
 	s.Index.Insert(req.Path, req.IndividualFile)
 	s.Index.Traverse() // Print the Trie after the update
+	s.Health.Notify()  // Check the new resource without waiting for the next round
+	s.Events.Publish(&pb.Event{Path: req.GetPath(), Type: nc.EventRegistered})
 
 	return &pb.RegisterFileResponse{Success: true}, nil
 }
@@ -234,6 +278,8 @@ func (s *NexusServer) RegisterDirectory(ctx context.Context, req *pb.RegisterDir
 
 	s.Index.Insert(req.GetPath(), req.GetDirectory())
 	s.Index.Traverse() // Print the Trie after the update
+	s.Health.Notify()  // Check the new resource without waiting for the next round
+	s.Events.Publish(&pb.Event{Path: req.GetPath(), Type: nc.EventRegistered})
 
 	return &pb.RegisterDirectoryResponse{Success: true}, nil
 }
@@ -245,6 +291,30 @@ func (s *NexusServer) RegisterDatabaseTable(ctx context.Context, req *pb.Registe
 
 	s.Index.Insert(req.GetPath(), req.GetDatabaseTable())
 	s.Index.Traverse() // Print the Trie after the update
+	s.Health.Notify()  // Check the new resource without waiting for the next round
+	s.Events.Publish(&pb.Event{Path: req.GetPath(), Type: nc.EventRegistered})
 
 	return &pb.RegisterDatabaseTableResponse{Success: true}, nil
 }
+
+// RegisterQuery implements the publisher endpoint for registering queries
+func (s *NexusServer) RegisterQuery(ctx context.Context, req *pb.RegisterQueryRequest) (*pb.RegisterQueryResponse, error) {
+	log := logger.GetLogger()
+	log.Info("Received query registration request", "path", req.Path)
+
+	if err := nc.ValidateQuery(req.GetQuery()); err != nil {
+		log.Error("Invalid query", "path", req.Path, "error", err)
+		return &pb.RegisterQueryResponse{Success: false, Error: err.Error()}, nil
+	}
+	if _, err := queryConnection(s.Index, req.GetQuery()); err != nil {
+		log.Error("Invalid query connection", "path", req.Path, "error", err)
+		return &pb.RegisterQueryResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	s.Index.Insert(req.GetPath(), req.GetQuery())
+	s.Index.Traverse() // Print the Trie after the update
+	s.Health.Notify()  // Check the new resource without waiting for the next round
+	s.Events.Publish(&pb.Event{Path: req.GetPath(), Type: nc.EventRegistered})
+
+	return &pb.RegisterQueryResponse{Success: true}, nil
+}
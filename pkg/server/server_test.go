@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// newTestServer serves a new server over an in-memory connection, letting it open datasets
+// under roots, and returns it with a client connected to it
+func newTestServer(t *testing.T, roots ...string) (*NexusServer, *nc.NexusClient) {
+	t.Helper()
+	t.Setenv(DataRootsEnv, strings.Join(roots, string(os.PathListSeparator)))
+	server, err := NewServer("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterNexusServiceServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return server, nc.NewNexusClient(conn)
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"hash/maphash"
+	"math"
+	"math/bits"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// exactDistinctLimit is the number of distinct values counted exactly before the distinct
+// estimate falls back to HyperLogLog
+const exactDistinctLimit = 1024
+
+// columnStats accumulates the statistics of a single dataset column
+type columnStats struct {
+	name     string
+	nulls    int64
+	min      interface{}
+	max      interface{}
+	exact    map[string]struct{} // Distinct values while there are few enough to count exactly
+	distinct *hyperLogLog
+}
+
+func newColumnStats(name string) *columnStats {
+	return &columnStats{name: name, exact: map[string]struct{}{}, distinct: newHyperLogLog()}
+}
+
+func (c *columnStats) add(value interface{}) {
+	if value == nil {
+		c.nulls++
+		return
+	}
+	if c.min == nil || nc.CompareValues(value, c.min) < 0 {
+		c.min = value
+	}
+	if c.max == nil || nc.CompareValues(value, c.max) > 0 {
+		c.max = value
+	}
+
+	key := nc.FormatValue(value)
+	c.distinct.add(key)
+	if c.exact != nil {
+		c.exact[key] = struct{}{}
+		if len(c.exact) > exactDistinctLimit {
+			c.exact = nil
+		}
+	}
+}
+
+func (c *columnStats) proto() *pb.ColumnStats {
+	stats := &pb.ColumnStats{Name: c.name, NullCount: c.nulls}
+	if c.min != nil {
+		stats.Min = nc.RowToProto([]interface{}{c.min}).Cells[0]
+		stats.Max = nc.RowToProto([]interface{}{c.max}).Cells[0]
+	}
+	if c.exact != nil {
+		stats.DistinctEstimate = int64(len(c.exact))
+	} else {
+		stats.DistinctEstimate = c.distinct.estimate()
+	}
+	return stats
+}
+
+// hyperLogLogPrecision gives 2^12 registers, for a standard error of about 1.6%
+const hyperLogLogPrecision = 12
+
+// hyperLogLog estimates the number of distinct strings added to it in constant memory
+type hyperLogLog struct {
+	seed      maphash.Seed
+	registers []uint8
+}
+
+func newHyperLogLog() *hyperLogLog {
+	return &hyperLogLog{seed: maphash.MakeSeed(), registers: make([]uint8, 1<<hyperLogLogPrecision)}
+}
+
+func (h *hyperLogLog) add(value string) {
+	hash := maphash.String(h.seed, value)
+	index := hash >> (64 - hyperLogLogPrecision)
+	rank := uint8(bits.LeadingZeros64(hash<<hyperLogLogPrecision|1<<(hyperLogLogPrecision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+func (h *hyperLogLog) estimate() int64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, register := range h.registers {
+		sum += math.Ldexp(1, -int(register))
+		if register == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// Use linear counting while many registers are still empty
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(estimate + 0.5)
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	nc "nexus/pkg/client"
+	pb "nexus/pkg/proto"
+)
+
+// TestWatcher watches a directory as its files are created and removed, registering them with
+// the server under the watch's prefix
+func TestWatcher(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.csv"), []byte("id\n1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	server, client := newTestServer(t)
+	// Registered in an earlier run, for a file removed since
+	if err := client.PublishIndividualFile("/files/old.csv", nc.CreateIndividualFile(filepath.Join(dir, "old.csv"))); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher, err := nc.NewWatcher(client, nc.DirectoryWatch{Dir: dir, Prefix: "/files", DirectoryPath: "/dirs/files"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	watcher.Debounce = 10 * time.Millisecond
+	changes := make(chan string, 100)
+	watcher.OnChange = func(action string, path string) { changes <- action + " " + path }
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- watcher.Run(ctx) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// waitFor waits until the watcher reports a change, failing on a change in unexpected
+	waitFor := func(change string, unexpected ...string) {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case got := <-changes:
+				if got == change {
+					return
+				}
+				for _, bad := range unexpected {
+					if got == bad {
+						t.Fatalf("watcher reported %q", got)
+					}
+				}
+			case <-timeout:
+				t.Fatalf("watcher didn't report %q", change)
+			}
+		}
+	}
+	waitFor("deregistered /files/old.csv")
+	waitFor("registered /files/a.csv")
+	waitFor("refreshed /dirs/files")
+
+	if err := os.WriteFile(filepath.Join(dir, ".b.csv.tmp"), []byte("id\n2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "2024"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "2024", "b.csv"), []byte("id\n2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor("registered /files/2024/b.csv", "registered /files/.b.csv.tmp")
+
+	if err := os.Remove(filepath.Join(dir, "a.csv")); err != nil {
+		t.Fatal(err)
+	}
+	waitFor("deregistered /files/a.csv")
+	waitFor("refreshed /dirs/files")
+
+	node, err := server.Index.Snapshot("/dirs/files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if directory := node.Value.(*pb.Directory); directory.FileCount != 1 {
+		t.Errorf("directory counts %d files, want only the one left", directory.FileCount)
+	}
+	if _, err := server.Index.Snapshot("/files/.b.csv.tmp"); err == nil {
+		t.Error("hidden file was registered")
+	}
+}